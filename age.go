@@ -0,0 +1,64 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+// LeapDayPolicy selects when someone born on February 29 has their birthday
+// in a year that is not a leap year.
+type LeapDayPolicy int
+
+const (
+	// LeapDayMarch1 counts the birthday as reached on March 1, the day
+	// after February 28, as in English and New Zealand law. This matches
+	// AddYears and YearsSince.
+	LeapDayMarch1 LeapDayPolicy = iota
+
+	// LeapDayFeb28 counts the birthday as reached on February 28, the last
+	// day of February. More generally, a monthly anniversary that falls past
+	// the end of a month is moved back to the last day of that month, as
+	// AddMonthsClamped does.
+	LeapDayFeb28
+)
+
+// AgeAt returns the age in full years on the date on of someone born on
+// birth, following LeapDayMarch1. If on is before birth the years are
+// counted backwards, so the age is 0 within a year before birth and negative
+// earlier. Use LeapDayPolicy.AgeAt to choose another policy.
+func AgeAt(birth, on Date) int {
+	return LeapDayMarch1.AgeAt(birth, on)
+}
+
+// AgeBreakdown returns the age on the date on of someone born on birth in
+// years, months and days, following LeapDayMarch1, which makes it the same
+// as on.Sub(birth). All fields have the same sign, negative if on is before
+// birth. Use LeapDayPolicy.AgeBreakdown to choose another policy.
+func AgeBreakdown(birth, on Date) Period {
+	return LeapDayMarch1.AgeBreakdown(birth, on)
+}
+
+// AgeAt is like the package-level AgeAt but follows the policy p.
+func (p LeapDayPolicy) AgeAt(birth, on Date) int {
+	return p.AgeBreakdown(birth, on).Years
+}
+
+// AgeBreakdown is like the package-level AgeBreakdown but follows the
+// policy p.
+func (p LeapDayPolicy) AgeBreakdown(birth, on Date) Period {
+	if p != LeapDayFeb28 {
+		return on.Sub(birth)
+	}
+	months := monthsSince(DateTime{Date: on}, DateTime{Date: birth}, Date.AddMonthsClamped)
+	days := on.DaysSince(birth.AddMonthsClamped(months))
+	return Period{Years: months / 12, Months: months % 12, Days: days}
+}
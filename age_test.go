@@ -0,0 +1,70 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgeAt(t *testing.T) {
+	type TC struct {
+		Birth Date
+		On    Date
+		March int
+		Feb   int
+	}
+	tcs := []TC{
+		TC{Birth: Date{1990, 6, 15}, On: Date{2020, 6, 14}, March: 29, Feb: 29},
+		TC{Birth: Date{1990, 6, 15}, On: Date{2020, 6, 15}, March: 30, Feb: 30},
+		TC{Birth: Date{2000, 2, 29}, On: Date{2021, 2, 28}, March: 20, Feb: 21},
+		TC{Birth: Date{2000, 2, 29}, On: Date{2021, 3, 1}, March: 21, Feb: 21},
+		TC{Birth: Date{2000, 2, 29}, On: Date{2020, 2, 28}, March: 19, Feb: 19},
+		TC{Birth: Date{2000, 2, 29}, On: Date{2020, 2, 29}, March: 20, Feb: 20},
+		TC{Birth: Date{2000, 2, 29}, On: Date{2000, 2, 29}, March: 0, Feb: 0},
+		TC{Birth: Date{2000, 2, 29}, On: Date{1999, 3, 1}, March: 0, Feb: 0},
+		TC{Birth: Date{2000, 2, 29}, On: Date{1999, 2, 28}, March: -1, Feb: -1},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.March, AgeAt(tc.Birth, tc.On), "%v on %v", tc.Birth, tc.On)
+		assert.Equal(t, tc.March, LeapDayMarch1.AgeAt(tc.Birth, tc.On), "%v on %v", tc.Birth, tc.On)
+		assert.Equal(t, tc.Feb, LeapDayFeb28.AgeAt(tc.Birth, tc.On), "%v on %v", tc.Birth, tc.On)
+	}
+}
+
+func TestAgeBreakdown(t *testing.T) {
+	type TC struct {
+		Birth Date
+		On    Date
+		March string
+		Feb   string
+	}
+	tcs := []TC{
+		TC{Birth: Date{1990, 6, 15}, On: Date{2020, 8, 20}, March: "P30Y2M5D", Feb: "P30Y2M5D"},
+		TC{Birth: Date{2000, 2, 29}, On: Date{2021, 2, 28}, March: "P20Y11M30D", Feb: "P21Y"},
+		TC{Birth: Date{2000, 2, 29}, On: Date{2021, 3, 1}, March: "P21Y", Feb: "P21Y1D"},
+		TC{Birth: Date{2020, 1, 31}, On: Date{2020, 2, 29}, March: "P29D", Feb: "P1M"},
+		TC{Birth: Date{2020, 1, 31}, On: Date{2020, 3, 1}, March: "P30D", Feb: "P1M1D"},
+		TC{Birth: Date{2020, 3, 31}, On: Date{2020, 2, 29}, March: "P-1M-2D", Feb: "P-1M"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.March, AgeBreakdown(tc.Birth, tc.On).String(), "%v on %v", tc.Birth, tc.On)
+		assert.Equal(t, tc.March, LeapDayMarch1.AgeBreakdown(tc.Birth, tc.On).String(), "%v on %v", tc.Birth, tc.On)
+		p := LeapDayFeb28.AgeBreakdown(tc.Birth, tc.On)
+		assert.Equal(t, tc.Feb, p.String(), "%v on %v", tc.Birth, tc.On)
+		assert.Equal(t, tc.On, tc.Birth.AddMonthsClamped(p.Years*12+p.Months).AddDays(p.Days), "%v on %v", tc.Birth, tc.On)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Package-level sinks make the results escape, as they do in real callers.
+var (
+	allocBytes []byte
+	allocStr   string
+	allocValue driver.Value
+)
+
+func TestAllocs(t *testing.T) {
+	d := Date{2020, 2, 29}
+	tm := Time{3, 42, 31, 876543219}
+	dt := DateTime{d, tm}
+	buf := make([]byte, 0, 64)
+
+	type TC struct {
+		Name string
+		F    func()
+		Max  float64
+	}
+	tcs := []TC{
+		TC{Name: "Date.AppendJSON", F: func() { allocBytes, _ = d.AppendJSON(buf[:0]) }, Max: 0},
+		TC{Name: "Time.AppendJSON", F: func() { allocBytes, _ = tm.AppendJSON(buf[:0]) }, Max: 0},
+		TC{Name: "DateTime.AppendJSON", F: func() { allocBytes, _ = dt.AppendJSON(buf[:0]) }, Max: 0},
+		TC{Name: "DateTime.AppendText", F: func() { allocBytes, _ = dt.AppendText(buf[:0]) }, Max: 0},
+		TC{Name: "DateTime.AppendFormat", F: func() { allocBytes = dt.AppendFormat(buf[:0], RFC3339DateTime) }, Max: 0},
+		TC{Name: "Date.MarshalJSON", F: func() { allocBytes, _ = d.MarshalJSON() }, Max: 1},
+		TC{Name: "Time.MarshalJSON", F: func() { allocBytes, _ = tm.MarshalJSON() }, Max: 1},
+		TC{Name: "DateTime.MarshalJSON", F: func() { allocBytes, _ = dt.MarshalJSON() }, Max: 1},
+		TC{Name: "DateTime.MarshalText", F: func() { allocBytes, _ = dt.MarshalText() }, Max: 1},
+		TC{Name: "Date.String", F: func() { allocStr = d.String() }, Max: 1},
+		TC{Name: "Time.String", F: func() { allocStr = tm.String() }, Max: 1},
+		TC{Name: "DateTime.String", F: func() { allocStr = dt.String() }, Max: 1},
+		// The string is boxed into the driver.Value interface.
+		TC{Name: "DateTime.Value", F: func() { allocValue, _ = dt.Value() }, Max: 2},
+	}
+	for _, tc := range tcs {
+		assert.LessOrEqual(t, testing.AllocsPerRun(100, tc.F), tc.Max, tc.Name)
+	}
+}
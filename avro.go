@@ -0,0 +1,140 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// The Avro helpers convert civil values to and from the underlying integers
+// of the Avro logical types, as used in schemas for goavro and Kafka:
+//
+//	date                    int: days since 1970-01-01
+//	time-millis             int: milliseconds since midnight
+//	time-micros             long: microseconds since midnight
+//	local-timestamp-millis  long: milliseconds since 1970-01-01T00:00:00
+//	local-timestamp-micros  long: microseconds since 1970-01-01T00:00:00
+//
+// The local timestamps carry no time zone, so they correspond to DateTime.
+// Fractions of a second finer than the unit are truncated toward the past.
+// The conversions to integers assume valid values.
+
+const dayMicros = int64(24 * time.Hour / time.Microsecond)
+
+// AvroDate returns d as an Avro date, the number of days since 1970-01-01.
+func (d Date) AvroDate() int32 {
+	return int32(d.DaysSinceEpoch())
+}
+
+// DateFromAvro returns the date of an Avro date.
+func DateFromAvro(days int32) Date {
+	return FromEpochDays(int64(days))
+}
+
+// AvroTimeMillis returns t as an Avro time-millis, the number of
+// milliseconds since midnight.
+func (t Time) AvroTimeMillis() int32 {
+	return int32(t.sinceMidnight() / time.Millisecond)
+}
+
+// TimeFromAvroMillis returns the time of an Avro time-millis. It returns an
+// error wrapping ErrInvalidTime if ms is not in [0,86400000]; the upper
+// bound is the end-of-day time 24:00.
+func TimeFromAvroMillis(ms int32) (Time, error) {
+	return timeSinceMidnight(time.Duration(ms) * time.Millisecond)
+}
+
+// AvroTimeMicros returns t as an Avro time-micros, the number of
+// microseconds since midnight.
+func (t Time) AvroTimeMicros() int64 {
+	return int64(t.sinceMidnight() / time.Microsecond)
+}
+
+// TimeFromAvroMicros returns the time of an Avro time-micros. It returns an
+// error wrapping ErrInvalidTime if us is not in [0,86400000000]; the upper
+// bound is the end-of-day time 24:00.
+func TimeFromAvroMicros(us int64) (Time, error) {
+	if us < 0 || us > dayMicros {
+		return Time{}, fmt.Errorf("%w: %d microseconds is not in a day", ErrInvalidTime, us)
+	}
+	return timeSinceMidnight(time.Duration(us) * time.Microsecond)
+}
+
+// AvroLocalTimestampMillis returns dt as an Avro local-timestamp-millis, the
+// number of milliseconds since 1970-01-01T00:00:00.
+func (dt DateTime) AvroLocalTimestampMillis() int64 {
+	ms, _ := dt.localTimestamp(time.Millisecond)
+	return ms
+}
+
+// DateTimeFromAvroLocalTimestampMillis returns the datetime of an Avro
+// local-timestamp-millis.
+func DateTimeFromAvroLocalTimestampMillis(ms int64) DateTime {
+	return dateTimeFromLocalTimestamp(ms, time.Millisecond)
+}
+
+// AvroLocalTimestampMicros returns dt as an Avro local-timestamp-micros, the
+// number of microseconds since 1970-01-01T00:00:00.
+func (dt DateTime) AvroLocalTimestampMicros() int64 {
+	us, _ := dt.localTimestamp(time.Microsecond)
+	return us
+}
+
+// DateTimeFromAvroLocalTimestampMicros returns the datetime of an Avro
+// local-timestamp-micros.
+func DateTimeFromAvroLocalTimestampMicros(us int64) DateTime {
+	return dateTimeFromLocalTimestamp(us, time.Microsecond)
+}
+
+// localTimestamp returns the number of units since 1970-01-01T00:00:00,
+// truncated toward the past, and reports whether it fits in an int64. Every
+// valid datetime fits for units of a microsecond or longer.
+func (dt DateTime) localTimestamp(unit time.Duration) (int64, bool) {
+	days := dt.Date.DaysSinceEpoch()
+	perDay := int64(24 * time.Hour / unit)
+	v := days * perDay
+	if v/perDay != days {
+		return 0, false
+	}
+	w := v + int64(dt.Time.sinceMidnight()/unit)
+	return w, (w >= v) == (dt.Time.sinceMidnight() >= 0)
+}
+
+// dateTimeFromLocalTimestamp returns the datetime v units after
+// 1970-01-01T00:00:00.
+func dateTimeFromLocalTimestamp(v int64, unit time.Duration) DateTime {
+	perDay := int64(24 * time.Hour / unit)
+	t, _ := timeSinceMidnight(time.Duration(floorMod(v, perDay)) * unit)
+	return DateTime{Date: FromEpochDays(floorDiv(v, perDay)), Time: t}
+}
+
+// floorDiv returns a/b rounded toward negative infinity, for b > 0.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
+
+// floorMod returns a - floorDiv(a, b)*b, which is in [0,b) for b > 0.
+func floorMod(a, b int64) int64 {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
@@ -0,0 +1,92 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvro_Date(t *testing.T) {
+	type TC struct {
+		In  Date
+		Out int32
+	}
+	tcs := []TC{
+		TC{In: Date{1970, 1, 1}, Out: 0},
+		TC{In: Date{2020, 2, 29}, Out: 18321},
+		TC{In: Date{1969, 12, 31}, Out: -1},
+		TC{In: Date{0, 1, 1}, Out: -719528},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.AvroDate(), tc.In)
+		assert.Equal(t, tc.In, DateFromAvro(tc.Out), tc.Out)
+	}
+}
+
+func TestAvro_Time(t *testing.T) {
+	tm := Time{3, 42, 31, 876543219}
+	assert.Equal(t, int32(13351876), tm.AvroTimeMillis())
+	assert.Equal(t, int64(13351876543), tm.AvroTimeMicros())
+
+	tm0, err := TimeFromAvroMillis(13351876)
+	assert.NoError(t, err)
+	assert.Equal(t, Time{3, 42, 31, 876000000}, tm0)
+	tm0, err = TimeFromAvroMicros(13351876543)
+	assert.NoError(t, err)
+	assert.Equal(t, Time{3, 42, 31, 876543000}, tm0)
+
+	tm0, err = TimeFromAvroMicros(86400000000)
+	assert.NoError(t, err)
+	assert.True(t, tm0.IsEndOfDay())
+}
+
+func TestAvro_LocalTimestamp(t *testing.T) {
+	type TC struct {
+		In     DateTime
+		Millis int64
+		Micros int64
+	}
+	tcs := []TC{
+		TC{In: DateTime{Date{1970, 1, 1}, Time{0, 0, 0, 0}}, Millis: 0, Micros: 0},
+		TC{In: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876543000}}, Millis: 1582947751876, Micros: 1582947751876543},
+		TC{In: DateTime{Date{1969, 12, 31}, Time{23, 59, 59, 999000000}}, Millis: -1, Micros: -1000},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Millis, tc.In.AvroLocalTimestampMillis(), tc.In)
+		assert.Equal(t, tc.Micros, tc.In.AvroLocalTimestampMicros(), tc.In)
+		assert.Equal(t, tc.In, DateTimeFromAvroLocalTimestampMicros(tc.Micros), tc.Micros)
+	}
+
+	// Sub-millisecond fractions are truncated toward the past.
+	dt := DateTime{Date{1969, 12, 31}, Time{23, 59, 59, 999999999}}
+	assert.Equal(t, int64(-1), dt.AvroLocalTimestampMillis())
+	assert.Equal(t, DateTime{Date{1969, 12, 31}, Time{23, 59, 59, 999000000}}, DateTimeFromAvroLocalTimestampMillis(-1))
+}
+
+/* === ERRORS === */
+
+func TestAvro_Errors(t *testing.T) {
+	for _, ms := range []int32{-1, 86400001} {
+		_, err := TimeFromAvroMillis(ms)
+		assert.True(t, errors.Is(err, ErrInvalidTime), ms)
+	}
+	for _, us := range []int64{-1, 86400000001, 1 << 62} {
+		_, err := TimeFromAvroMicros(us)
+		assert.True(t, errors.Is(err, ErrInvalidTime), us)
+	}
+}
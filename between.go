@@ -0,0 +1,97 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+// DaysBetween returns the number of whole days from start to end. It is
+// negative if end is before start, and is the same as end.DaysSince(start).
+func DaysBetween(start, end Date) int {
+	return end.DaysSince(start)
+}
+
+// MonthsBetween returns the number of whole months from start to end,
+// truncated toward zero. It is the same as end.MonthsSince(start).
+func MonthsBetween(start, end Date) int {
+	return end.MonthsSince(start)
+}
+
+// YearsBetween returns the number of whole years from start to end,
+// truncated toward zero. It is the same as end.YearsSince(start).
+func YearsBetween(start, end Date) int {
+	return end.YearsSince(start)
+}
+
+// MonthsSince returns the signed number of whole months between the date and
+// s, counting a month as whole once the day of the month of s is reached.
+// It is the largest n for which s.AddMonths(n) is not after d, or for d
+// before s the smallest n for which s.AddMonths(n) is not before d, so
+// 2020-01-31 to 2020-02-29 is 0 months and 2020-03-31 to 2020-02-29 is -1.
+func (d Date) MonthsSince(s Date) int {
+	return monthsSince(DateTime{Date: d}, DateTime{Date: s}, Date.AddMonths)
+}
+
+// YearsSince returns the signed number of whole years between the date and
+// s, which is d.MonthsSince(s) / 12. A birthday on February 29 is reached on
+// March 1 in years that are not leap years.
+func (d Date) YearsSince(s Date) int {
+	return d.MonthsSince(s) / 12
+}
+
+// DaysSince returns the signed number of whole days between the date and
+// time and s, truncated toward zero: a day is whole once the time of day of
+// s is reached, so 2020-01-01T12:00 to 2020-01-02T11:59 is 0 days.
+func (dt DateTime) DaysSince(s DateTime) int {
+	days := dt.Date.DaysSince(s.Date)
+	shifted := DateTime{Date: s.Date.AddDays(days), Time: s.Time}
+	switch {
+	case days > 0 && shifted.After(dt):
+		days--
+	case days < 0 && shifted.Before(dt):
+		days++
+	}
+	return days
+}
+
+// MonthsSince returns the signed number of whole months between the date and
+// time and s, truncated toward zero. A month is whole once both the day of
+// the month and the time of day of s are reached, as for Date.MonthsSince.
+func (dt DateTime) MonthsSince(s DateTime) int {
+	return monthsSince(dt, s, Date.AddMonths)
+}
+
+// monthsSince returns the signed number of whole months between dt and s,
+// truncated toward zero, where s moved by n months is the date
+// addMonths(s.Date, n) at the time of day of s.
+func monthsSince(dt, s DateTime, addMonths func(Date, int) Date) int {
+	months := (dt.Date.Year-s.Date.Year)*12 + int(dt.Date.Month-s.Date.Month)
+	shifted := func(n int) DateTime {
+		return DateTime{Date: addMonths(s.Date, n), Time: s.Time}
+	}
+	if !dt.Before(s) {
+		for months > 0 && shifted(months).After(dt) {
+			months--
+		}
+	} else {
+		for months < 0 && shifted(months).Before(dt) {
+			months++
+		}
+	}
+	return months
+}
+
+// YearsSince returns the signed number of whole years between the date and
+// time and s, which is dt.MonthsSince(s) / 12.
+func (dt DateTime) YearsSince(s DateTime) int {
+	return dt.MonthsSince(s) / 12
+}
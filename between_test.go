@@ -0,0 +1,79 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBetween_Dates(t *testing.T) {
+	type TC struct {
+		Start  Date
+		End    Date
+		Days   int
+		Months int
+		Years  int
+	}
+	tcs := []TC{
+		TC{Start: Date{2020, 1, 1}, End: Date{2020, 1, 1}, Days: 0, Months: 0, Years: 0},
+		TC{Start: Date{2020, 1, 15}, End: Date{2020, 2, 14}, Days: 30, Months: 0, Years: 0},
+		TC{Start: Date{2020, 1, 15}, End: Date{2020, 2, 15}, Days: 31, Months: 1, Years: 0},
+		TC{Start: Date{2020, 1, 31}, End: Date{2020, 2, 29}, Days: 29, Months: 0, Years: 0},
+		TC{Start: Date{2020, 2, 29}, End: Date{2021, 2, 28}, Days: 365, Months: 11, Years: 0},
+		TC{Start: Date{2020, 2, 29}, End: Date{2021, 3, 1}, Days: 366, Months: 12, Years: 1},
+		TC{Start: Date{2000, 6, 15}, End: Date{2020, 6, 14}, Days: 7304, Months: 239, Years: 19},
+		TC{Start: Date{2020, 2, 15}, End: Date{2020, 1, 16}, Days: -30, Months: 0, Years: 0},
+		TC{Start: Date{2020, 3, 31}, End: Date{2020, 2, 29}, Days: -31, Months: -1, Years: 0},
+		TC{Start: Date{2020, 6, 14}, End: Date{2000, 6, 15}, Days: -7304, Months: -239, Years: -19},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Days, DaysBetween(tc.Start, tc.End), "%v to %v", tc.Start, tc.End)
+		assert.Equal(t, tc.Months, MonthsBetween(tc.Start, tc.End), "%v to %v", tc.Start, tc.End)
+		assert.Equal(t, tc.Years, YearsBetween(tc.Start, tc.End), "%v to %v", tc.Start, tc.End)
+	}
+}
+
+func TestBetween_DateTimes(t *testing.T) {
+	type TC struct {
+		Start  string
+		End    string
+		Days   int
+		Months int
+		Years  int
+	}
+	tcs := []TC{
+		TC{Start: "2020-01-01T12:00:00", End: "2020-01-02T11:59:59", Days: 0, Months: 0, Years: 0},
+		TC{Start: "2020-01-01T12:00:00", End: "2020-01-02T12:00:00", Days: 1, Months: 0, Years: 0},
+		TC{Start: "2020-01-15T08:00:00", End: "2020-02-15T07:00:00", Days: 30, Months: 0, Years: 0},
+		TC{Start: "2020-01-15T08:00:00", End: "2020-02-15T08:00:00", Days: 31, Months: 1, Years: 0},
+		TC{Start: "2019-03-01T00:00:00", End: "2020-03-01T00:00:00", Days: 366, Months: 12, Years: 1},
+		TC{Start: "2019-03-01T00:00:01", End: "2020-03-01T00:00:00", Days: 365, Months: 11, Years: 0},
+		TC{Start: "2020-01-02T11:59:59", End: "2020-01-01T12:00:00", Days: 0, Months: 0, Years: 0},
+		TC{Start: "2020-02-15T07:00:00", End: "2020-01-15T08:00:00", Days: -30, Months: 0, Years: 0},
+		TC{Start: "2020-02-15T08:00:00", End: "2020-01-15T08:00:00", Days: -31, Months: -1, Years: 0},
+	}
+	for _, tc := range tcs {
+		start, err := ParseDateTime(tc.Start)
+		assert.NoError(t, err)
+		end, err := ParseDateTime(tc.End)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tc.Days, end.DaysSince(start), "%v to %v", tc.Start, tc.End)
+		assert.Equal(t, tc.Months, end.MonthsSince(start), "%v to %v", tc.Start, tc.End)
+		assert.Equal(t, tc.Years, end.YearsSince(start), "%v to %v", tc.Start, tc.End)
+	}
+}
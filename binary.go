@@ -0,0 +1,149 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = Date{}
+	_ encoding.BinaryUnmarshaler = (*Date)(nil)
+	_ encoding.BinaryMarshaler   = Time{}
+	_ encoding.BinaryUnmarshaler = (*Time)(nil)
+	_ encoding.BinaryMarshaler   = DateTime{}
+	_ encoding.BinaryUnmarshaler = (*DateTime)(nil)
+)
+
+// The binary encodings are fixed-size and big-endian, and for valid values
+// they sort bytewise in chronological order, so they can serve as cache and
+// index keys. A DateTime is the Date encoding followed by the Time encoding;
+// nanosecond precision over the years [0,9999] does not fit in 8 bytes.
+const (
+	dateBinaryLen     = 4
+	timeBinaryLen     = 8
+	dateTimeBinaryLen = dateBinaryLen + timeBinaryLen
+)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, which
+// also makes Date usable with encoding/gob. The date is encoded in 4 bytes:
+// the year as a 16-bit integer with its sign bit flipped, then the month and
+// the day. It returns an error wrapping ErrYearOutOfRange if the year is
+// outside [-32768,32767], and ErrInvalidMonth or ErrInvalidDay if the month
+// or day is outside [0,255]. Any other date, including the zero Date,
+// round-trips exactly.
+func (d Date) MarshalBinary() ([]byte, error) {
+	return d.appendBinary(make([]byte, 0, dateBinaryLen))
+}
+
+func (d Date) appendBinary(b []byte) ([]byte, error) {
+	switch {
+	case d.Year < math.MinInt16 || d.Year > math.MaxInt16:
+		return nil, fmt.Errorf("Date.MarshalBinary: %w: %d does not fit in 16 bits", ErrYearOutOfRange, d.Year)
+	case d.Month < 0 || d.Month > math.MaxUint8:
+		return nil, fmt.Errorf("Date.MarshalBinary: %w: %d does not fit in a byte", ErrInvalidMonth, d.Month)
+	case d.Day < 0 || d.Day > math.MaxUint8:
+		return nil, fmt.Errorf("Date.MarshalBinary: %w: %d does not fit in a byte", ErrInvalidDay, d.Day)
+	}
+	b = append(b, 0, 0)
+	binary.BigEndian.PutUint16(b[len(b)-2:], uint16(d.Year)^0x8000)
+	return append(b, byte(d.Month), byte(d.Day)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface,
+// decoding the format written by MarshalBinary.
+func (d *Date) UnmarshalBinary(data []byte) error {
+	if len(data) != dateBinaryLen {
+		return fmt.Errorf("Date.UnmarshalBinary: %w: got %d bytes, want %d", ErrInvalidFormat, len(data), dateBinaryLen)
+	}
+	*d = decodeDate(data)
+	return nil
+}
+
+func decodeDate(data []byte) Date {
+	return Date{
+		Year:  int(int16(binary.BigEndian.Uint16(data) ^ 0x8000)),
+		Month: time.Month(data[2]),
+		Day:   int(data[3]),
+	}
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, which
+// also makes Time usable with encoding/gob. The time is encoded in 8 bytes as
+// the number of nanoseconds since midnight. It returns an error wrapping
+// ErrInvalidTime if t is not valid; the end-of-day time 24:00 is allowed.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return t.appendBinary(make([]byte, 0, timeBinaryLen))
+}
+
+func (t Time) appendBinary(b []byte) ([]byte, error) {
+	if !t.IsEndOfDay() {
+		if err := checkTime(t.Hour, t.Minute, t.Second, t.Nanosecond); err != nil {
+			return nil, fmt.Errorf("Time.MarshalBinary: %w", err)
+		}
+	}
+	b = append(b, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.BigEndian.PutUint64(b[len(b)-timeBinaryLen:], uint64(t.sinceMidnight()))
+	return b, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface,
+// decoding the format written by MarshalBinary.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	if len(data) != timeBinaryLen {
+		return fmt.Errorf("Time.UnmarshalBinary: %w: got %d bytes, want %d", ErrInvalidFormat, len(data), timeBinaryLen)
+	}
+	val, err := decodeTime(data)
+	if err != nil {
+		return fmt.Errorf("Time.UnmarshalBinary: %w", err)
+	}
+	*t = val
+	return nil
+}
+
+func decodeTime(data []byte) (Time, error) {
+	return timeSinceMidnight(time.Duration(binary.BigEndian.Uint64(data)))
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, which
+// also makes DateTime usable with encoding/gob. The datetime is encoded in 12
+// bytes: the 4-byte encoding of the date followed by the 8-byte encoding of
+// the time, with the same restrictions as Date.MarshalBinary and
+// Time.MarshalBinary.
+func (dt DateTime) MarshalBinary() ([]byte, error) {
+	b, err := dt.Date.appendBinary(make([]byte, 0, dateTimeBinaryLen))
+	if err != nil {
+		return nil, err
+	}
+	return dt.Time.appendBinary(b)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface,
+// decoding the format written by MarshalBinary.
+func (dt *DateTime) UnmarshalBinary(data []byte) error {
+	if len(data) != dateTimeBinaryLen {
+		return fmt.Errorf("DateTime.UnmarshalBinary: %w: got %d bytes, want %d", ErrInvalidFormat, len(data), dateTimeBinaryLen)
+	}
+	t, err := decodeTime(data[dateBinaryLen:])
+	if err != nil {
+		return fmt.Errorf("DateTime.UnmarshalBinary: %w", err)
+	}
+	*dt = DateTime{Date: decodeDate(data), Time: t}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_Binary_RoundTrip(t *testing.T) {
+	type TC struct {
+		In  Date
+		Out []byte
+	}
+	tcs := []TC{
+		TC{In: Date{}, Out: []byte{0x80, 0x00, 0, 0}},
+		TC{In: Date{2020, 2, 29}, Out: []byte{0x87, 0xe4, 2, 29}},
+		TC{In: Date{-44, 3, 15}, Out: []byte{0x7f, 0xd4, 3, 15}},
+		TC{In: Date{2020, 13, 32}, Out: []byte{0x87, 0xe4, 13, 32}},
+	}
+	for _, tc := range tcs {
+		b, err := tc.In.MarshalBinary()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.Out, b, "%v", tc.In)
+
+		var d Date
+		assert.NoError(t, d.UnmarshalBinary(b))
+		assert.Equal(t, tc.In, d)
+	}
+
+	_, err := Date{40000, 1, 1}.MarshalBinary()
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+	_, err = Date{2020, 1, 256}.MarshalBinary()
+	assert.True(t, errors.Is(err, ErrInvalidDay))
+	var d Date
+	assert.True(t, errors.Is(d.UnmarshalBinary([]byte{1, 2, 3}), ErrInvalidFormat))
+}
+
+func TestTime_Binary_RoundTrip(t *testing.T) {
+	for _, tm := range []Time{{}, {3, 42, 31, 876}, {23, 59, 59, 999999999}, {Hour: 24}} {
+		b, err := tm.MarshalBinary()
+		assert.NoError(t, err)
+		assert.Len(t, b, 8)
+
+		var got Time
+		assert.NoError(t, got.UnmarshalBinary(b))
+		assert.Equal(t, tm, got)
+	}
+
+	_, err := Time{3, 60, 0, 0}.MarshalBinary()
+	assert.True(t, errors.Is(err, ErrInvalidTime))
+	var tm Time
+	assert.True(t, errors.Is(tm.UnmarshalBinary([]byte{0xff, 0, 0, 0, 0, 0, 0, 0}), ErrInvalidTime))
+	assert.True(t, errors.Is(tm.UnmarshalBinary([]byte{0}), ErrInvalidFormat))
+}
+
+func TestDateTime_Binary_RoundTrip(t *testing.T) {
+	for _, dt := range []DateTime{{}, {Date{2020, 2, 29}, Time{3, 42, 31, 876}}} {
+		b, err := dt.MarshalBinary()
+		assert.NoError(t, err)
+		assert.Len(t, b, 12)
+
+		var got DateTime
+		assert.NoError(t, got.UnmarshalBinary(b))
+		assert.Equal(t, dt, got)
+	}
+}
+
+func TestBinary_Order(t *testing.T) {
+	dts := []DateTime{
+		{Date{-1, 12, 31}, Time{23, 59, 59, 0}},
+		{Date{0, 1, 1}, Time{}},
+		{Date{2020, 2, 29}, Time{3, 42, 31, 876}},
+		{Date{2020, 2, 29}, Time{3, 42, 31, 877}},
+		{Date{2020, 3, 1}, Time{}},
+	}
+	for i := 1; i < len(dts); i++ {
+		a, _ := dts[i-1].MarshalBinary()
+		b, _ := dts[i].MarshalBinary()
+		assert.Equal(t, -1, bytes.Compare(a, b), "%v < %v", dts[i-1], dts[i])
+	}
+}
+
+func TestBinary_Gob(t *testing.T) {
+	type Event struct {
+		Day   Date
+		Start Time
+		At    DateTime
+	}
+	in := Event{Date{2020, 2, 29}, Time{Hour: 9}, DateTime{Date{2020, 2, 29}, Time{9, 30, 0, 5}}}
+
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(in))
+	var out Event
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+	assert.Equal(t, in, out)
+}
@@ -0,0 +1,215 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// The MarshalBSONValue methods of Date, Time and DateTime store values as
+// BSON strings in the format written by String. Strings keep nanosecond
+// precision and sort chronologically for years in [0,9999]. To store BSON
+// datetimes instead, give fields the type BSONDate or BSONDateTime. The
+// UnmarshalBSONValue methods of all these types accept both strings,
+// parsed as by UnmarshalText, and datetimes, and decode BSON null as the
+// zero value.
+
+// BSONDate is a Date stored in BSON as a UTC datetime at midnight, which
+// MongoDB date operators and indexes understand. Convert with BSONDate(d)
+// and Date(v).
+type BSONDate Date
+
+// BSONDateTime is a DateTime stored in BSON as a UTC datetime, treating
+// the wall-clock time as UTC. BSON datetimes have millisecond precision, so
+// finer fractions of a second are truncated. A Time has no BSON
+// counterpart and is always stored as a string.
+type BSONDateTime DateTime
+
+// BSON element types, from the BSON specification.
+const (
+	bsonString   byte = 0x02
+	bsonDateTime byte = 0x09
+	bsonNull     byte = 0x0A
+)
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface of the
+// MongoDB Go driver (go.mongodb.org/mongo-driver/v2/bson), storing the date
+// as a string.
+func (d Date) MarshalBSONValue() (byte, []byte, error) {
+	return bsonString, appendBSONString(nil, d.String()), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface of the
+// MongoDB Go driver.
+func (d *Date) UnmarshalBSONValue(typ byte, data []byte) error {
+	var val Date
+	switch typ {
+	case bsonNull:
+	case bsonDateTime:
+		t, err := decodeBSONDateTime(data)
+		if err != nil {
+			return err
+		}
+		val = DateOf(t)
+	case bsonString:
+		s, err := decodeBSONString(data)
+		if err != nil {
+			return err
+		}
+		if val, err = decodeOptions().ParseDate(s); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: cannot decode BSON type 0x%02x into Date", ErrUnsupportedType, typ)
+	}
+	*d = val
+	return nil
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface of the
+// MongoDB Go driver, storing the time as a string.
+func (t Time) MarshalBSONValue() (byte, []byte, error) {
+	return bsonString, appendBSONString(nil, t.String()), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface of the
+// MongoDB Go driver. A datetime is converted to its UTC time of day.
+func (t *Time) UnmarshalBSONValue(typ byte, data []byte) error {
+	var val Time
+	switch typ {
+	case bsonNull:
+	case bsonDateTime:
+		tm, err := decodeBSONDateTime(data)
+		if err != nil {
+			return err
+		}
+		val = TimeOf(tm)
+	case bsonString:
+		s, err := decodeBSONString(data)
+		if err != nil {
+			return err
+		}
+		if val, err = decodeOptions().ParseTime(s); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: cannot decode BSON type 0x%02x into Time", ErrUnsupportedType, typ)
+	}
+	*t = val
+	return nil
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface of the
+// MongoDB Go driver, storing the datetime as a string.
+func (dt DateTime) MarshalBSONValue() (byte, []byte, error) {
+	return bsonString, appendBSONString(nil, dt.String()), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface of the
+// MongoDB Go driver.
+func (dt *DateTime) UnmarshalBSONValue(typ byte, data []byte) error {
+	var val DateTime
+	switch typ {
+	case bsonNull:
+	case bsonDateTime:
+		t, err := decodeBSONDateTime(data)
+		if err != nil {
+			return err
+		}
+		val = DateTimeOf(t)
+	case bsonString:
+		s, err := decodeBSONString(data)
+		if err != nil {
+			return err
+		}
+		if val, err = decodeOptions().ParseDateTime(s); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: cannot decode BSON type 0x%02x into DateTime", ErrUnsupportedType, typ)
+	}
+	*dt = val
+	return nil
+}
+
+// String returns the date in the format of Date.String.
+func (v BSONDate) String() string {
+	return Date(v).String()
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface of the
+// MongoDB Go driver, storing the date as a datetime.
+func (v BSONDate) MarshalBSONValue() (byte, []byte, error) {
+	return bsonDateTime, appendBSONDateTime(nil, Date(v).In(time.UTC)), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface of the
+// MongoDB Go driver.
+func (v *BSONDate) UnmarshalBSONValue(typ byte, data []byte) error {
+	return (*Date)(v).UnmarshalBSONValue(typ, data)
+}
+
+// String returns the datetime in the format of DateTime.String.
+func (v BSONDateTime) String() string {
+	return DateTime(v).String()
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface of the
+// MongoDB Go driver, storing the datetime as a datetime.
+func (v BSONDateTime) MarshalBSONValue() (byte, []byte, error) {
+	return bsonDateTime, appendBSONDateTime(nil, DateTime(v).In(time.UTC)), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface of the
+// MongoDB Go driver.
+func (v *BSONDateTime) UnmarshalBSONValue(typ byte, data []byte) error {
+	return (*DateTime)(v).UnmarshalBSONValue(typ, data)
+}
+
+// appendBSONString appends s as a BSON string: its length including the
+// terminating NUL as a little-endian int32, the bytes and a NUL.
+func appendBSONString(b []byte, s string) []byte {
+	var n [4]byte
+	binary.LittleEndian.PutUint32(n[:], uint32(len(s)+1))
+	b = append(b, n[:]...)
+	b = append(b, s...)
+	return append(b, 0)
+}
+
+func decodeBSONString(data []byte) (string, error) {
+	if len(data) < 5 || int(binary.LittleEndian.Uint32(data)) != len(data)-4 || data[len(data)-1] != 0 {
+		return "", fmt.Errorf("%w: malformed BSON string", ErrInvalidFormat)
+	}
+	return string(data[4 : len(data)-1]), nil
+}
+
+// appendBSONDateTime appends t as a BSON datetime, the number of
+// milliseconds since the Unix epoch as a little-endian int64.
+func appendBSONDateTime(b []byte, t time.Time) []byte {
+	var n [8]byte
+	ms := t.Unix()*1000 + int64(t.Nanosecond()/1e6)
+	binary.LittleEndian.PutUint64(n[:], uint64(ms))
+	return append(b, n[:]...)
+}
+
+func decodeBSONDateTime(data []byte) (time.Time, error) {
+	if len(data) != 8 {
+		return time.Time{}, fmt.Errorf("%w: malformed BSON datetime", ErrInvalidFormat)
+	}
+	ms := int64(binary.LittleEndian.Uint64(data))
+	return time.Unix(ms/1000, ms%1000*1e6).UTC(), nil
+}
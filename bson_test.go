@@ -0,0 +1,98 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBSON_String(t *testing.T) {
+	typ, data, err := Date{2020, 2, 29}.MarshalBSONValue()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x02), typ)
+	assert.Equal(t, append([]byte{11, 0, 0, 0}, "2020-02-29\x00"...), data)
+
+	var d Date
+	assert.NoError(t, d.UnmarshalBSONValue(typ, data))
+	assert.Equal(t, Date{2020, 2, 29}, d)
+
+	tm := Time{3, 42, 31, 876}
+	typ, data, err = tm.MarshalBSONValue()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x02), typ)
+	var tm0 Time
+	assert.NoError(t, tm0.UnmarshalBSONValue(typ, data))
+	assert.Equal(t, tm, tm0)
+
+	dt := DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876}}
+	typ, data, err = dt.MarshalBSONValue()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x02), typ)
+	var dt0 DateTime
+	assert.NoError(t, dt0.UnmarshalBSONValue(typ, data))
+	assert.Equal(t, dt, dt0)
+}
+
+func TestBSON_DateTime(t *testing.T) {
+	typ, data, err := BSONDate{1970, 1, 2}.MarshalBSONValue()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x09), typ)
+	assert.Equal(t, []byte{0x00, 0x5c, 0x26, 0x05, 0, 0, 0, 0}, data) // 86400000
+
+	var d Date
+	assert.NoError(t, d.UnmarshalBSONValue(typ, data))
+	assert.Equal(t, Date{1970, 1, 2}, d)
+	var bd BSONDate
+	assert.NoError(t, bd.UnmarshalBSONValue(typ, data))
+	assert.Equal(t, BSONDate{1970, 1, 2}, bd)
+
+	dt := DateTime{Date{1969, 12, 31}, Time{23, 59, 59, 999876000}}
+	typ, data, err = BSONDateTime(dt).MarshalBSONValue()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x09), typ)
+	var dt0 DateTime
+	assert.NoError(t, dt0.UnmarshalBSONValue(typ, data))
+	assert.Equal(t, DateTime{Date{1969, 12, 31}, Time{23, 59, 59, 999000000}}, dt0)
+
+	var tm Time
+	assert.NoError(t, tm.UnmarshalBSONValue(typ, data))
+	assert.Equal(t, Time{23, 59, 59, 999000000}, tm)
+
+	// The wrappers read strings too.
+	_, data, err = dt.MarshalBSONValue()
+	assert.NoError(t, err)
+	var bdt BSONDateTime
+	assert.NoError(t, bdt.UnmarshalBSONValue(0x02, data))
+	assert.Equal(t, BSONDateTime(dt), bdt)
+	assert.Equal(t, "1969-12-31T23:59:59.999876000", bdt.String())
+
+	typ, _, err = Time{Hour: 9}.MarshalBSONValue()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x02), typ)
+}
+
+func TestBSON_Unmarshal_Errors(t *testing.T) {
+	d := Date{2020, 2, 29}
+	assert.NoError(t, d.UnmarshalBSONValue(0x0A, nil))
+	assert.Equal(t, Date{}, d)
+
+	assert.True(t, errors.Is(d.UnmarshalBSONValue(0x10, []byte{1, 0, 0, 0}), ErrUnsupportedType))
+	assert.True(t, errors.Is(d.UnmarshalBSONValue(0x02, []byte{3, 0, 0, 0, 'x'}), ErrInvalidFormat))
+	assert.True(t, errors.Is(d.UnmarshalBSONValue(0x09, []byte{1, 2}), ErrInvalidFormat))
+	assert.True(t, errors.Is(d.UnmarshalBSONValue(0x02, append([]byte{11, 0, 0, 0}, "2021-02-29\x00"...)), ErrInvalidDay))
+}
@@ -0,0 +1,113 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+)
+
+// The bulk helpers append whole slices of civil values, for exporters that
+// write large columns. They grow dst once for the whole slice and do not go
+// through reflection. The JSON helpers write a JSON array of the values as
+// MarshalJSON writes them; the text helpers write the values as String
+// does, separated by sep. On error dst is returned unchanged.
+
+// AppendDatesJSON appends ds to dst as a JSON array.
+func AppendDatesJSON(dst []byte, ds []Date) ([]byte, error) {
+	b := grow(dst, len(ds)*(len(RFC3339Date)+3)+2)
+	b = append(b, '[')
+	for i, d := range ds {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		var err error
+		if b, err = d.AppendJSON(b); err != nil {
+			return dst, fmt.Errorf("civil: element %d: %w", i, err)
+		}
+	}
+	return append(b, ']'), nil
+}
+
+// AppendTimesJSON appends ts to dst as a JSON array.
+func AppendTimesJSON(dst []byte, ts []Time) ([]byte, error) {
+	b := grow(dst, len(ts)*(len(RFC3339Time)+3)+2)
+	b = append(b, '[')
+	for i, t := range ts {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b, _ = t.AppendJSON(b)
+	}
+	return append(b, ']'), nil
+}
+
+// AppendDateTimesJSON appends dts to dst as a JSON array.
+func AppendDateTimesJSON(dst []byte, dts []DateTime) ([]byte, error) {
+	b := grow(dst, len(dts)*(len(RFC3339DateTime)+3)+2)
+	b = append(b, '[')
+	for i, dt := range dts {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b, _ = dt.AppendJSON(b)
+	}
+	return append(b, ']'), nil
+}
+
+// AppendDatesText appends ds to dst separated by sep.
+func AppendDatesText(dst []byte, ds []Date, sep string) []byte {
+	b := grow(dst, len(ds)*(len(RFC3339Date)+len(sep)))
+	for i, d := range ds {
+		if i > 0 {
+			b = append(b, sep...)
+		}
+		b = d.appendString(b)
+	}
+	return b
+}
+
+// AppendTimesText appends ts to dst separated by sep.
+func AppendTimesText(dst []byte, ts []Time, sep string) []byte {
+	b := grow(dst, len(ts)*(len(RFC3339Time)+len(sep)))
+	for i, t := range ts {
+		if i > 0 {
+			b = append(b, sep...)
+		}
+		b = t.appendString(b)
+	}
+	return b
+}
+
+// AppendDateTimesText appends dts to dst separated by sep.
+func AppendDateTimesText(dst []byte, dts []DateTime, sep string) []byte {
+	b := grow(dst, len(dts)*(len(RFC3339DateTime)+len(sep)))
+	for i, dt := range dts {
+		if i > 0 {
+			b = append(b, sep...)
+		}
+		b = dt.appendString(b)
+	}
+	return b
+}
+
+// grow returns b with room for at least n more bytes.
+func grow(b []byte, n int) []byte {
+	if cap(b)-len(b) >= n {
+		return b
+	}
+	g := make([]byte, len(b), len(b)+n)
+	copy(g, b)
+	return g
+}
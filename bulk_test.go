@@ -0,0 +1,77 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendJSON_Bulk(t *testing.T) {
+	ds := []Date{{2020, 2, 29}, {2021, 3, 4}}
+	b, err := AppendDatesJSON([]byte("x="), ds)
+	assert.NoError(t, err)
+	assert.Equal(t, `x=["2020-02-29","2021-03-04"]`, string(b))
+
+	ts := []Time{{Hour: 9}, {3, 42, 31, 876000000}}
+	b, err = AppendTimesJSON(nil, ts)
+	assert.NoError(t, err)
+	want, _ := json.Marshal(ts)
+	assert.Equal(t, string(want), string(b))
+
+	dts := []DateTime{{Date{2020, 2, 29}, Time{Hour: 9}}}
+	b, err = AppendDateTimesJSON(nil, dts)
+	assert.NoError(t, err)
+	assert.Equal(t, `["2020-02-29T09:00:00"]`, string(b))
+
+	b, err = AppendDatesJSON(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(b))
+}
+
+func TestAppendText_Bulk(t *testing.T) {
+	ds := []Date{{2020, 2, 29}, {2021, 3, 4}}
+	assert.Equal(t, "2020-02-29\n2021-03-04", string(AppendDatesText(nil, ds, "\n")))
+
+	ts := []Time{{Hour: 9}, {Hour: 17, Minute: 30}}
+	assert.Equal(t, "09:00:00,17:30:00", string(AppendTimesText(nil, ts, ",")))
+
+	dts := []DateTime{{Date{2020, 2, 29}, Time{Hour: 9}}, {Date{2021, 3, 4}, Time{}}}
+	assert.Equal(t, "2020-02-29T09:00:00 | 2021-03-04T00:00:00", string(AppendDateTimesText(nil, dts, " | ")))
+
+	assert.Equal(t, "", string(AppendDatesText(nil, nil, ",")))
+}
+
+func TestAppendText_Bulk_Allocs(t *testing.T) {
+	dts := make([]DateTime, 1000)
+	for i := range dts {
+		dts[i] = DateTime{Date{2020, 2, 29}.AddDays(i), Time{3, 42, 31, 876543219}}
+	}
+	n := testing.AllocsPerRun(10, func() { allocBytes, _ = AppendDateTimesJSON(nil, dts) })
+	assert.Equal(t, float64(1), n)
+}
+
+/* === ERRORS === */
+
+func TestAppendJSON_Bulk_Errors(t *testing.T) {
+	dst := []byte("x=")
+	b, err := AppendDatesJSON(dst, []Date{{2020, 2, 29}, {10000, 1, 1}})
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+	assert.Contains(t, err.Error(), "element 1")
+	assert.Equal(t, "x=", string(b))
+}
@@ -0,0 +1,163 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// FromOrdinalDate returns the date that is the given day of the year,
+// counting January 1 as day 1. It returns an error if day is not in the
+// range [1,365], or [1,366] in a leap year.
+func FromOrdinalDate(year, day int) (Date, error) {
+	d := Date{Year: year, Month: time.January, Day: 1}.AddDays(day - 1)
+	if day < 1 || d.Year != year {
+		return Date{}, fmt.Errorf("%w: %d out of range for year %d", ErrInvalidDay, day, year)
+	}
+	return d, nil
+}
+
+// DateFromDayOfYear returns the date that is day doy of the year, counting
+// January 1 as day 1. It is the same as FromOrdinalDate, and returns an
+// error wrapping ErrInvalidDay if doy is not in the range [1,365], or
+// [1,366] in a leap year.
+func DateFromDayOfYear(year, doy int) (Date, error) {
+	return FromOrdinalDate(year, doy)
+}
+
+// DayOfYear returns the day of the year of d, in the range [1,365] for
+// common years and [1,366] in leap years.
+func (d Date) DayOfYear() int {
+	return d.In(time.UTC).YearDay()
+}
+
+// OrdinalDate returns the date in ISO 8601 extended ordinal-date format,
+// YYYY-DDD, where DDD is the day of the year starting at 001.
+func (d Date) OrdinalDate() string {
+	b := make([]byte, 0, len("2006-002"))
+	b = appendInt(b, d.Year, 4, '0')
+	b = append(b, '-')
+	b = appendInt(b, d.DayOfYear(), 3, '0')
+	return string(b)
+}
+
+// ParseOrdinalDate parses an ISO 8601 ordinal date in either the extended
+// format YYYY-DDD, as produced by OrdinalDate, or the basic format YYYYDDD,
+// and returns the date it represents. A malformed string is an error
+// wrapping ErrInvalidFormat, and a day that is out of range for the year is
+// an error wrapping ErrInvalidDay.
+func ParseOrdinalDate(s string) (Date, error) {
+	var yearPart, dayPart string
+	switch {
+	case len(s) == len("2006-002") && s[4] == '-':
+		yearPart, dayPart = s[:4], s[5:]
+	case len(s) == len("2006002"):
+		yearPart, dayPart = s[:4], s[4:]
+	default:
+		return Date{}, fmt.Errorf("%w: %q is not an ISO 8601 ordinal date", ErrInvalidFormat, s)
+	}
+
+	year, err := parseDigits(yearPart)
+	if err != nil {
+		return Date{}, fmt.Errorf("%w: invalid year in ordinal date %q", ErrInvalidFormat, s)
+	}
+	day, err := parseDigits(dayPart)
+	if err != nil {
+		return Date{}, fmt.Errorf("%w: invalid day in ordinal date %q", ErrInvalidFormat, s)
+	}
+	return FromOrdinalDate(year, day)
+}
+
+// IsLeapYear reports whether year is a leap year in the proleptic Gregorian
+// calendar: a multiple of 4 that is not a multiple of 100 unless it is also
+// a multiple of 400, so 2000 is a leap year but 1900 is not.
+func IsLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// DaysInYear returns the number of days in year, 366 for a leap year and
+// 365 otherwise.
+func DaysInYear(year int) int {
+	if IsLeapYear(year) {
+		return 366
+	}
+	return 365
+}
+
+// DaysInMonth returns the number of days in the month of year, from 28 to
+// 31.
+func DaysInMonth(year int, month time.Month) int {
+	return daysIn(month, year)
+}
+
+// DaysInMonth returns the number of days in the month containing d.
+func (d Date) DaysInMonth() int {
+	return daysIn(d.Month, d.Year)
+}
+
+// lastInstant is the last representable time of day, one nanosecond before
+// midnight.
+var lastInstant = Time{Hour: 23, Minute: 59, Second: 59, Nanosecond: 999999999}
+
+// StartOfMonth returns the first day of the month containing d.
+func (d Date) StartOfMonth() Date {
+	return Date{Year: d.Year, Month: d.Month, Day: 1}
+}
+
+// EndOfMonth returns the last day of the month containing d, which is
+// February 29 in a leap year.
+func (d Date) EndOfMonth() Date {
+	return Date{Year: d.Year, Month: d.Month, Day: d.DaysInMonth()}
+}
+
+// StartOfYear returns January 1 of the year containing d.
+func (d Date) StartOfYear() Date {
+	return Date{Year: d.Year, Month: time.January, Day: 1}
+}
+
+// EndOfYear returns December 31 of the year containing d.
+func (d Date) EndOfYear() Date {
+	return Date{Year: d.Year, Month: time.December, Day: 31}
+}
+
+// StartOfMonth returns midnight at the start of the first day of the month
+// containing dt.
+func (dt DateTime) StartOfMonth() DateTime {
+	return DateTime{Date: dt.Date.StartOfMonth()}
+}
+
+// EndOfMonth returns 23:59:59.999999999 on the last day of the month
+// containing dt.
+func (dt DateTime) EndOfMonth() DateTime {
+	return DateTime{Date: dt.Date.EndOfMonth(), Time: lastInstant}
+}
+
+// StartOfYear returns midnight at the start of January 1 of the year
+// containing dt.
+func (dt DateTime) StartOfYear() DateTime {
+	return DateTime{Date: dt.Date.StartOfYear()}
+}
+
+// EndOfYear returns 23:59:59.999999999 on December 31 of the year containing
+// dt.
+func (dt DateTime) EndOfYear() DateTime {
+	return DateTime{Date: dt.Date.EndOfYear(), Time: lastInstant}
+}
+
+// daysIn returns the number of days in the month of the year.
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,172 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrdinalDate_RoundTrip(t *testing.T) {
+	type TC struct {
+		In  Date
+		Out string
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 1, 1}, Out: "2020-001"},
+		TC{In: Date{2020, 2, 29}, Out: "2020-060"},
+		TC{In: Date{2020, 12, 31}, Out: "2020-366"},
+		TC{In: Date{2021, 3, 1}, Out: "2021-060"},
+		TC{In: Date{2021, 12, 31}, Out: "2021-365"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.OrdinalDate())
+
+		d, err := ParseOrdinalDate(tc.Out)
+		assert.NoError(t, err, tc.Out)
+		assert.Equal(t, tc.In, d, tc.Out)
+	}
+}
+
+func TestFromOrdinalDate(t *testing.T) {
+	d, err := FromOrdinalDate(2020, 366)
+	assert.NoError(t, err)
+	assert.Equal(t, Date{2020, 12, 31}, d)
+
+	_, err = FromOrdinalDate(2021, 366)
+	assert.EqualError(t, err, "civil: invalid day: 366 out of range for year 2021")
+	assert.True(t, errors.Is(err, ErrInvalidDay))
+	_, err = FromOrdinalDate(2021, 0)
+	assert.Error(t, err)
+	_, err = FromOrdinalDate(2021, -1)
+	assert.Error(t, err)
+}
+
+func TestDate_DayOfYear(t *testing.T) {
+	type TC struct {
+		In  Date
+		Out int
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 1, 1}, Out: 1},
+		TC{In: Date{2020, 3, 1}, Out: 61},
+		TC{In: Date{2021, 3, 1}, Out: 60},
+		TC{In: Date{2000, 12, 31}, Out: 366},
+		TC{In: Date{1900, 12, 31}, Out: 365},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.DayOfYear(), "%v", tc.In)
+
+		d, err := DateFromDayOfYear(tc.In.Year, tc.Out)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.In, d)
+	}
+
+	for _, doy := range []int{0, -1, 366} {
+		_, err := DateFromDayOfYear(1900, doy)
+		assert.True(t, errors.Is(err, ErrInvalidDay), "%d", doy)
+	}
+}
+
+func TestParseOrdinalDate(t *testing.T) {
+	d, err := ParseOrdinalDate("2020060")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{2020, 2, 29}, d)
+
+	type TC struct {
+		In  string
+		Err error
+	}
+	tcs := []TC{
+		TC{In: "2020-60", Err: ErrInvalidFormat},
+		TC{In: "2020-000", Err: ErrInvalidDay},
+		TC{In: "2021-366", Err: ErrInvalidDay},
+		TC{In: "2020/060", Err: ErrInvalidFormat},
+		TC{In: "20x0-060", Err: ErrInvalidFormat},
+		TC{In: "2020--60", Err: ErrInvalidFormat},
+	}
+	for _, tc := range tcs {
+		_, err := ParseOrdinalDate(tc.In)
+		assert.True(t, errors.Is(err, tc.Err), "%s: %v", tc.In, err)
+	}
+}
+
+func TestIsLeapYear(t *testing.T) {
+	type TC struct {
+		Year int
+		Leap bool
+	}
+	tcs := []TC{
+		TC{Year: 2020, Leap: true},
+		TC{Year: 2021, Leap: false},
+		TC{Year: 2000, Leap: true},
+		TC{Year: 1900, Leap: false},
+		TC{Year: 2100, Leap: false},
+		TC{Year: 2400, Leap: true},
+		TC{Year: 0, Leap: true},
+		TC{Year: -4, Leap: true},
+		TC{Year: -100, Leap: false},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Leap, IsLeapYear(tc.Year), "%d", tc.Year)
+		assert.Equal(t, Date{tc.Year, 12, 31}.DayOfYear(), DaysInYear(tc.Year), "%d", tc.Year)
+		assert.Equal(t, tc.Leap, DaysInMonth(tc.Year, time.February) == 29, "%d", tc.Year)
+	}
+}
+
+func TestDaysInMonth(t *testing.T) {
+	want := []int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	for i, n := range want {
+		month := time.Month(i + 1)
+		assert.Equal(t, n, DaysInMonth(2021, month), "%v", month)
+		assert.Equal(t, n, Date{2021, month, 1}.DaysInMonth(), "%v", month)
+	}
+	assert.Equal(t, 29, Date{2020, 2, 29}.DaysInMonth())
+}
+
+func TestDate_MonthYearBounds(t *testing.T) {
+	type TC struct {
+		In           Date
+		StartOfMonth Date
+		EndOfMonth   Date
+		StartOfYear  Date
+		EndOfYear    Date
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 2, 14}, StartOfMonth: Date{2020, 2, 1}, EndOfMonth: Date{2020, 2, 29}, StartOfYear: Date{2020, 1, 1}, EndOfYear: Date{2020, 12, 31}},
+		TC{In: Date{2021, 2, 14}, StartOfMonth: Date{2021, 2, 1}, EndOfMonth: Date{2021, 2, 28}, StartOfYear: Date{2021, 1, 1}, EndOfYear: Date{2021, 12, 31}},
+		TC{In: Date{1900, 2, 1}, StartOfMonth: Date{1900, 2, 1}, EndOfMonth: Date{1900, 2, 28}, StartOfYear: Date{1900, 1, 1}, EndOfYear: Date{1900, 12, 31}},
+		TC{In: Date{2000, 2, 29}, StartOfMonth: Date{2000, 2, 1}, EndOfMonth: Date{2000, 2, 29}, StartOfYear: Date{2000, 1, 1}, EndOfYear: Date{2000, 12, 31}},
+		TC{In: Date{2020, 4, 30}, StartOfMonth: Date{2020, 4, 1}, EndOfMonth: Date{2020, 4, 30}, StartOfYear: Date{2020, 1, 1}, EndOfYear: Date{2020, 12, 31}},
+		TC{In: Date{2020, 12, 31}, StartOfMonth: Date{2020, 12, 1}, EndOfMonth: Date{2020, 12, 31}, StartOfYear: Date{2020, 1, 1}, EndOfYear: Date{2020, 12, 31}},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.StartOfMonth, tc.In.StartOfMonth(), "%v", tc.In)
+		assert.Equal(t, tc.EndOfMonth, tc.In.EndOfMonth(), "%v", tc.In)
+		assert.Equal(t, tc.StartOfYear, tc.In.StartOfYear(), "%v", tc.In)
+		assert.Equal(t, tc.EndOfYear, tc.In.EndOfYear(), "%v", tc.In)
+	}
+}
+
+func TestDateTime_MonthYearBounds(t *testing.T) {
+	dt := DateTime{Date{2020, 2, 14}, Time{3, 42, 31, 5}}
+	assert.Equal(t, "2020-02-01T00:00:00", dt.StartOfMonth().String())
+	assert.Equal(t, "2020-02-29T23:59:59.999999999", dt.EndOfMonth().String())
+	assert.Equal(t, "2020-01-01T00:00:00", dt.StartOfYear().String())
+	assert.Equal(t, "2020-12-31T23:59:59.999999999", dt.EndOfYear().String())
+}
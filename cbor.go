@@ -0,0 +1,250 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// The CBOR methods implement the Marshaler and Unmarshaler interfaces of
+// github.com/fxamacker/cbor without importing it:
+//
+//   - A Date is written as a full-date text string with tag 1004 (RFC 8943).
+//     Tag 100, the number of days since 1970-01-01, is also accepted.
+//   - A Time is written as an untagged text string, since CBOR has no tag for
+//     a time of day.
+//   - A DateTime is written as a standard date/time string with tag 0
+//     (RFC 8949), treating the wall-clock time as UTC. A tag 0 string with
+//     another offset is converted to UTC.
+//
+// Untagged text strings are parsed as by UnmarshalText, and CBOR null
+// decodes as the zero value.
+
+// CBOR major types and tags, from RFC 8949 and RFC 8943.
+const (
+	cborUnsigned = 0
+	cborNegative = 1
+	cborText     = 3
+	cborTag      = 6
+	cborNull     = 0xf6
+
+	cborTagDateTime = 0
+	cborTagDays     = 100
+	cborTagFullDate = 1004
+)
+
+// MarshalCBOR writes the date as a tag 1004 full-date string.
+func (d Date) MarshalCBOR() ([]byte, error) {
+	b := appendCBORHead(make([]byte, 0, 3+1+len(RFC3339Date)), cborTag, cborTagFullDate)
+	return appendCBORText(b, d.String()), nil
+}
+
+// UnmarshalCBOR decodes a full-date string, tagged 1004 or untagged, or a
+// tag 100 day count.
+func (d *Date) UnmarshalCBOR(data []byte) error {
+	if isCBORNull(data) {
+		*d = Date{}
+		return nil
+	}
+	tag, tagged, rest, err := readCBORTag(data)
+	if err != nil {
+		return err
+	}
+	var val Date
+	switch {
+	case tagged && tag == cborTagDays:
+		days, err := readCBORInt(rest)
+		if err != nil {
+			return err
+		}
+		val = FromEpochDays(days)
+	case !tagged || tag == cborTagFullDate:
+		s, err := readCBORText(rest)
+		if err != nil {
+			return err
+		}
+		if val, err = decodeOptions().ParseDate(s); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: cannot decode CBOR tag %d into Date", ErrUnsupportedType, tag)
+	}
+	*d = val
+	return nil
+}
+
+// MarshalCBOR writes the time as an untagged text string.
+func (t Time) MarshalCBOR() ([]byte, error) {
+	return appendCBORText(nil, t.String()), nil
+}
+
+// UnmarshalCBOR decodes an untagged text string.
+func (t *Time) UnmarshalCBOR(data []byte) error {
+	if isCBORNull(data) {
+		*t = Time{}
+		return nil
+	}
+	s, err := readCBORText(data)
+	if err != nil {
+		return err
+	}
+	val, err := decodeOptions().ParseTime(s)
+	if err != nil {
+		return err
+	}
+	*t = val
+	return nil
+}
+
+// MarshalCBOR writes the datetime as a tag 0 date/time string in UTC.
+func (dt DateTime) MarshalCBOR() ([]byte, error) {
+	b := appendCBORHead(nil, cborTag, cborTagDateTime)
+	s := dt.appendString(make([]byte, 0, len(RFC3339DateTime)+1))
+	return appendCBORText(b, string(append(s, 'Z'))), nil
+}
+
+// UnmarshalCBOR decodes a tag 0 date/time string, converting it to UTC, or
+// an untagged text string.
+func (dt *DateTime) UnmarshalCBOR(data []byte) error {
+	if isCBORNull(data) {
+		*dt = DateTime{}
+		return nil
+	}
+	tag, tagged, rest, err := readCBORTag(data)
+	if err != nil {
+		return err
+	}
+	if tagged && tag != cborTagDateTime {
+		return fmt.Errorf("%w: cannot decode CBOR tag %d into DateTime", ErrUnsupportedType, tag)
+	}
+	s, err := readCBORText(rest)
+	if err != nil {
+		return err
+	}
+
+	var val DateTime
+	if tagged {
+		var loc *time.Location
+		val, loc, err = decodeOptions().ParseDateTimeOffset(s)
+		if err == nil && loc != nil {
+			val = DateTimeOf(val.In(loc).UTC())
+		}
+	} else {
+		val, err = decodeOptions().ParseDateTime(s)
+	}
+	if err != nil {
+		return err
+	}
+	*dt = val
+	return nil
+}
+
+// appendCBORHead appends the initial byte and argument of a data item of
+// the given major type.
+func appendCBORHead(b []byte, major byte, n uint64) []byte {
+	major <<= 5
+	switch {
+	case n < 24:
+		return append(b, major|byte(n))
+	case n <= 0xff:
+		return append(b, major|24, byte(n))
+	case n <= 0xffff:
+		return append(b, major|25, byte(n>>8), byte(n))
+	case n <= 0xffffffff:
+		return append(b, major|26, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], n)
+	return append(append(b, major|27), buf[:]...)
+}
+
+func appendCBORText(b []byte, s string) []byte {
+	return append(appendCBORHead(b, cborText, uint64(len(s))), s...)
+}
+
+func isCBORNull(data []byte) bool {
+	return len(data) == 1 && data[0] == cborNull
+}
+
+// readCBORHead reads the major type and argument of the data item at the
+// start of data. Indefinite lengths are not supported.
+func readCBORHead(data []byte) (major byte, n uint64, rest []byte, err error) {
+	if len(data) == 0 {
+		return 0, 0, nil, fmt.Errorf("%w: empty CBOR data item", ErrInvalidFormat)
+	}
+	major, info := data[0]>>5, data[0]&0x1f
+	data = data[1:]
+	var size int
+	switch {
+	case info < 24:
+		return major, uint64(info), data, nil
+	case info <= 27:
+		size = 1 << (info - 24)
+	default:
+		return 0, 0, nil, fmt.Errorf("%w: unsupported CBOR additional information %d", ErrInvalidFormat, info)
+	}
+	if len(data) < size {
+		return 0, 0, nil, fmt.Errorf("%w: truncated CBOR data item", ErrInvalidFormat)
+	}
+	for _, c := range data[:size] {
+		n = n<<8 | uint64(c)
+	}
+	return major, n, data[size:], nil
+}
+
+// readCBORTag reads an optional tag from the start of data.
+func readCBORTag(data []byte) (tag uint64, tagged bool, rest []byte, err error) {
+	major, n, rest, err := readCBORHead(data)
+	if err != nil || major != cborTag {
+		return 0, false, data, err
+	}
+	return n, true, rest, nil
+}
+
+// readCBORText reads a definite-length text string that makes up all of data.
+func readCBORText(data []byte) (string, error) {
+	major, n, rest, err := readCBORHead(data)
+	if err != nil {
+		return "", err
+	}
+	if major != cborText {
+		return "", fmt.Errorf("%w: CBOR major type %d is not a text string", ErrUnsupportedType, major)
+	}
+	if uint64(len(rest)) != n {
+		return "", fmt.Errorf("%w: CBOR text string length %d does not match %d bytes of data", ErrInvalidFormat, n, len(rest))
+	}
+	return string(rest), nil
+}
+
+// readCBORInt reads an integer that makes up all of data.
+func readCBORInt(data []byte) (int64, error) {
+	major, n, rest, err := readCBORHead(data)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case major != cborUnsigned && major != cborNegative:
+		return 0, fmt.Errorf("%w: CBOR major type %d is not an integer", ErrUnsupportedType, major)
+	case len(rest) != 0:
+		return 0, fmt.Errorf("%w: trailing bytes after CBOR integer", ErrInvalidFormat)
+	case n > 1<<62:
+		return 0, fmt.Errorf("%w: CBOR integer out of range", ErrInvalidFormat)
+	case major == cborNegative:
+		return -1 - int64(n), nil
+	}
+	return int64(n), nil
+}
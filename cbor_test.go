@@ -0,0 +1,103 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCBOR_Date(t *testing.T) {
+	d := Date{2020, 2, 29}
+	data, err := d.MarshalCBOR()
+	assert.NoError(t, err)
+	assert.Equal(t, append([]byte{0xd9, 0x03, 0xec, 0x6a}, "2020-02-29"...), data)
+
+	var d0 Date
+	assert.NoError(t, d0.UnmarshalCBOR(data))
+	assert.Equal(t, d, d0)
+
+	// Untagged and tag 100 (days since the epoch).
+	assert.NoError(t, d0.UnmarshalCBOR(append([]byte{0x6a}, "2021-03-04"...)))
+	assert.Equal(t, Date{2021, 3, 4}, d0)
+	assert.NoError(t, d0.UnmarshalCBOR([]byte{0xd8, 0x64, 0x19, 0x47, 0xf0}))
+	assert.Equal(t, Date{2020, 6, 3}, d0)
+	assert.NoError(t, d0.UnmarshalCBOR([]byte{0xd8, 0x64, 0x20}))
+	assert.Equal(t, Date{1969, 12, 31}, d0)
+
+	assert.NoError(t, d0.UnmarshalCBOR([]byte{0xf6}))
+	assert.Equal(t, Date{}, d0)
+}
+
+func TestCBOR_Time(t *testing.T) {
+	tm := Time{3, 42, 31, 876000000}
+	data, err := tm.MarshalCBOR()
+	assert.NoError(t, err)
+	assert.Equal(t, append([]byte{0x72}, "03:42:31.876000000"...), data)
+
+	var tm0 Time
+	assert.NoError(t, tm0.UnmarshalCBOR(data))
+	assert.Equal(t, tm, tm0)
+}
+
+func TestCBOR_DateTime(t *testing.T) {
+	dt := DateTime{Date{2020, 2, 29}, Time{3, 42, 0, 0}}
+	data, err := dt.MarshalCBOR()
+	assert.NoError(t, err)
+	assert.Equal(t, append([]byte{0xc0, 0x74}, "2020-02-29T03:42:00Z"...), data)
+
+	var dt0 DateTime
+	assert.NoError(t, dt0.UnmarshalCBOR(data))
+	assert.Equal(t, dt, dt0)
+
+	// Other offsets are converted to UTC.
+	assert.NoError(t, dt0.UnmarshalCBOR(append([]byte{0xc0, 0x78, 25}, "2020-02-29T05:42:00+02:00"...)))
+	assert.Equal(t, dt, dt0)
+
+	// Untagged strings are taken as written.
+	assert.NoError(t, dt0.UnmarshalCBOR(append([]byte{0x73}, "2020-02-29T05:42:00"...)))
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{5, 42, 0, 0}}, dt0)
+}
+
+/* === ERRORS === */
+
+func TestCBOR_Errors(t *testing.T) {
+	type TC struct {
+		In  []byte
+		Err error
+	}
+	tcs := []TC{
+		TC{In: nil, Err: ErrInvalidFormat},
+		TC{In: []byte{0x6a, '2', '0'}, Err: ErrInvalidFormat},
+		TC{In: []byte{0x7f}, Err: ErrInvalidFormat},
+		TC{In: []byte{0x79, 0x00}, Err: ErrInvalidFormat},
+		TC{In: []byte{0x19, 0x47, 0xf0}, Err: ErrUnsupportedType},
+		TC{In: []byte{0xc1, 0x1a, 0x5e, 0x59, 0xce, 0x00}, Err: ErrUnsupportedType},
+		TC{In: []byte{0xd8, 0x64, 0x61, '1'}, Err: ErrUnsupportedType},
+		TC{In: []byte{0xd8, 0x64, 0x01, 0x01}, Err: ErrInvalidFormat},
+		TC{In: append([]byte{0x6a}, "2020-02-30"...), Err: ErrInvalidDay},
+	}
+	for _, tc := range tcs {
+		var d Date
+		err := d.UnmarshalCBOR(tc.In)
+		assert.True(t, errors.Is(err, tc.Err), "%x: %v", tc.In, err)
+	}
+
+	var dt DateTime
+	err := dt.UnmarshalCBOR([]byte{0xd9, 0x03, 0xec, 0x60})
+	assert.True(t, errors.Is(err, ErrUnsupportedType), err)
+}
@@ -22,15 +22,27 @@
 package civil
 
 import (
-	"bytes"
 	"database/sql/driver"
+	"encoding"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
+// The text interfaces let Date, Time and DateTime serve as JSON object keys
+// and as values in encoding/xml and text-based configuration formats.
+var (
+	_ encoding.TextMarshaler   = Date{}
+	_ encoding.TextUnmarshaler = (*Date)(nil)
+	_ encoding.TextMarshaler   = Time{}
+	_ encoding.TextUnmarshaler = (*Time)(nil)
+	_ encoding.TextMarshaler   = DateTime{}
+	_ encoding.TextUnmarshaler = (*DateTime)(nil)
+)
+
 // A Date represents a date (year, month, day).
 //
 // This type does not include location information, and therefore does not
@@ -48,26 +60,133 @@ func DateOf(t time.Time) Date {
 	return d
 }
 
+// NewDate returns the date with the given year, month and day, or a
+// *FieldError wrapping ErrYearOutOfRange, ErrInvalidMonth or ErrInvalidDay if
+// there is no such date or its year is outside [MinYear,MaxYear].
+func NewDate(year int, month time.Month, day int) (Date, error) {
+	if err := checkDate(year, month, day); err != nil {
+		return Date{}, err
+	}
+	return Date{Year: year, Month: month, Day: day}, nil
+}
+
 // RFC3339Date is the civil date format of RFC3339
 const RFC3339Date = "2006-01-02"
 
+// ISO8601BasicDate is the ISO 8601 basic date format, for use with Format
+// and ParseDateLayout.
+const ISO8601BasicDate = "20060102"
+
+// MinYear and MaxYear bound the years accepted by the text and JSON
+// marshalers of Date and DateTime, NewDate and the Validate methods. The
+// default range [0,9999] is the one RFC 3339 allows. Archival and
+// far-future data may widen it; a year outside [0,9999] is then marshaled
+// in the ISO 8601 expanded form written by ExpandedString, which the
+// unmarshalers then accept.
+var (
+	MinYear = 0
+	MaxYear = 9999
+)
+
 // ParseDate parses a string in RFC3339 full-date format and returns the date value it represents.
+// The zero date "0000-00-00" parses as Date{}. Errors are of type *ParseError.
 func ParseDate(s string) (Date, error) {
-	const dateZero = "0000-00-00"
+	return ParseOptions{}.ParseDate(s)
+}
 
-	if s == dateZero {
-		return Date{}, nil
+// MustParseDate is like ParseDate but panics if s cannot be parsed. It
+// simplifies the initialization of global variables and test fixtures.
+func MustParseDate(s string) Date {
+	d, err := ParseDate(s)
+	if err != nil {
+		panic(err)
 	}
-	t, err := time.Parse(RFC3339Date, s)
+	return d
+}
+
+// ParseDateLayout parses value according to layout, which uses the
+// reference-time notation of time.Parse, and returns the date it represents.
+// Any time-of-day or zone elements in the layout are parsed but discarded.
+func ParseDateLayout(layout, value string) (Date, error) {
+	t, err := time.Parse(layout, value)
 	if err != nil {
-		return Date{}, err
+		return Date{}, layoutError(err)
 	}
 	return DateOf(t), nil
 }
 
+// ParseDateAny parses value with each of layouts in turn, as ParseDateLayout
+// does, and returns the date from the first layout that matches together
+// with that layout. If no layout matches, the error lists all of them.
+func ParseDateAny(value string, layouts ...string) (Date, string, error) {
+	for _, layout := range layouts {
+		if d, err := ParseDateLayout(layout, value); err == nil {
+			return d, layout, nil
+		}
+	}
+	return Date{}, "", noLayoutMatched(value, layouts)
+}
+
+func noLayoutMatched(value string, layouts []string) error {
+	return fmt.Errorf("civil: %q does not match any of the layouts %q", value, layouts)
+}
+
 // String returns the date in RFC3339 full-date format.
 func (d Date) String() string {
-	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+	return string(d.appendString(make([]byte, 0, len(RFC3339Date))))
+}
+
+func (d Date) appendString(b []byte) []byte {
+	b = appendInt(b, d.Year, 4, '0')
+	return d.appendMonthDay(b)
+}
+
+// ExpandedString returns the date in the ISO 8601 expanded representation if
+// its year is outside [0,9999]: the year has a leading '+' or '-' and at
+// least four digits, as in "+12020-03-04" and "-0044-03-15". Other dates are
+// written as by String. ParseOptions.ExpandedYears accepts the result.
+func (d Date) ExpandedString() string {
+	return string(d.appendExpanded(make([]byte, 0, len("+12020-03-04"))))
+}
+
+func (d Date) appendExpanded(b []byte) []byte {
+	switch {
+	case d.Year > 9999:
+		b = append(b, '+')
+		b = appendInt(b, d.Year, 4, '0')
+	case d.Year < 0:
+		b = append(b, '-')
+		b = appendInt(b, -d.Year, 4, '0')
+	default:
+		b = appendInt(b, d.Year, 4, '0')
+	}
+	return d.appendMonthDay(b)
+}
+
+func (d Date) appendMonthDay(b []byte) []byte {
+	b = append(b, '-')
+	b = appendInt(b, int(d.Month), 2, '0')
+	b = append(b, '-')
+	return appendInt(b, d.Day, 2, '0')
+}
+
+// Format returns a textual representation of the date formatted according
+// to layout, which uses the reference-time notation of time.Time.Format.
+//
+// The year, month, day, weekday and day-of-year elements ("2006", "06",
+// "January", "Jan", "1", "01", "2", "_2", "02", "Monday", "Mon", "002",
+// "__2") are all supported. Time-of-day elements ("15", "3", "04", "05",
+// "PM", fractional seconds) and zone elements ("MST", "-0700", "Z07:00")
+// are not meaningful for a Date: they format as midnight in UTC and should
+// not appear in the layout.
+func (d Date) Format(layout string) string {
+	return d.In(time.UTC).Format(layout)
+}
+
+// AppendFormat is like Format but appends the textual representation to b
+// and returns the extended buffer.
+func (d Date) AppendFormat(b []byte, layout string) []byte {
+	return d.In(time.UTC).AppendFormat(b, layout)
 }
 
 // IsValid reports whether the date is valid.
@@ -75,6 +194,15 @@ func (d Date) IsValid() bool {
 	return DateOf(d.In(time.UTC)) == d
 }
 
+// Normalize returns the valid date that d denotes when its out-of-range
+// fields are rolled over as time.Date does, so that month 13 is January of
+// the next year and October 32 is November 1. It also reports whether the
+// result differs from d.
+func (d Date) Normalize() (Date, bool) {
+	n := DateOf(d.In(time.UTC))
+	return n, n != d
+}
+
 // In returns the time corresponding to time 00:00:00 of the date in the location.
 //
 // In is always consistent with time.Date, even when time.Date returns a time
@@ -95,6 +223,15 @@ func (d Date) AddDays(n int) Date {
 	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
 }
 
+// AddDate returns the date that is the given number of years, months and
+// days in the future, any of which can be negative. As with time.Time's
+// AddDate, all three are added before the result is normalized once, so
+// Date{2020, 2, 29}.AddDate(1, 1, 0) is 2021-03-29 rather than the
+// 2021-04-01 that AddYears(1).AddMonths(1) gives.
+func (d Date) AddDate(years, months, days int) Date {
+	return DateOf(d.In(time.UTC).AddDate(years, months, days))
+}
+
 // AddMonths returns the date that is n months in the future.
 // n can also be negative to go into the past.
 func (d Date) AddMonths(n int) Date {
@@ -107,6 +244,27 @@ func (d Date) AddYears(n int) Date {
 	return DateOf(d.In(time.UTC).AddDate(n, 0, 0))
 }
 
+// AddMonthsClamped returns the date that is n months in the future, like
+// AddMonths, except that a day past the end of the resulting month is
+// clamped to its last day rather than overflowing into the next, so January
+// 31 plus one month is February 29 in a leap year instead of March 2.
+func (d Date) AddMonthsClamped(n int) Date {
+	first := DateOf(time.Date(d.Year, d.Month+time.Month(n), 1, 0, 0, 0, 0, time.UTC))
+	if last := daysIn(first.Month, first.Year); d.Day > last {
+		first.Day = last
+	} else {
+		first.Day = d.Day
+	}
+	return first
+}
+
+// AddYearsClamped returns the date that is n years in the future, like
+// AddYears, except that February 29 becomes February 28 in a year that is
+// not a leap year instead of March 1.
+func (d Date) AddYearsClamped(n int) Date {
+	return d.AddMonthsClamped(12 * n)
+}
+
 // DaysSince returns the signed number of days between the date and s, not including the end day.
 // This is the inverse operation to AddDays.
 func (d Date) DaysSince(s Date) (days int) {
@@ -133,26 +291,53 @@ func (d1 Date) After(d2 Date) bool {
 }
 
 // MarshalText implements the encoding.TextMarshaler interface.
-// The output is the result of d.String().
+// The output is the result of d.ExpandedString(). It returns an error
+// wrapping ErrYearOutOfRange if the year is outside [MinYear,MaxYear].
 func (d Date) MarshalText() ([]byte, error) {
-	return []byte(d.String()), nil
+	if err := checkYear(d.Year); err != nil {
+		return nil, fmt.Errorf("Date.MarshalText: %w", err)
+	}
+	return []byte(d.ExpandedString()), nil
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the result of d.ExpandedString() to b. On error b is returned
+// unchanged.
+func (d Date) AppendText(b []byte) ([]byte, error) {
+	if err := checkYear(d.Year); err != nil {
+		return b, fmt.Errorf("Date.AppendText: %w", err)
+	}
+	return d.appendExpanded(b), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
 // The date is expected to be a string in a format accepted by ParseDate.
+// Use ParseOptions.Target to accept other formats.
 func (d *Date) UnmarshalText(data []byte) error {
+	return d.unmarshalText(ParseOptions{}, data)
+}
+
+func (d *Date) unmarshalText(o ParseOptions, data []byte) error {
 	var err error
-	*d, err = ParseDate(string(data))
+	*d, err = o.decoding().ParseDate(string(data))
 	return err
 }
 
-// UnmarshalJSON implements encoding/json Unmarshaler interface
+// UnmarshalJSON implements encoding/json Unmarshaler interface. As for
+// time.Time, the JSON null value leaves the date unchanged.
 func (d *Date) UnmarshalJSON(data []byte) error {
+	return d.unmarshalJSON(ParseOptions{}, data)
+}
+
+func (d *Date) unmarshalJSON(o ParseOptions, data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
 	var s string
 	if err := json.Unmarshal(data, &s); err != nil {
-		return fmt.Errorf("date should be a string, got %s", data)
+		return fmt.Errorf("%w: date should be a string, got %s", ErrInvalidFormat, data)
 	}
-	val, err := ParseDate(s)
+	val, err := o.decoding().ParseDate(s)
 	if err != nil {
 		return errors.Wrapf(err, "invalid date: %s", s)
 	}
@@ -162,47 +347,108 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 
 // MarshalJSON implements encoding/json Marshaler interface
 func (d *Date) MarshalJSON() ([]byte, error) {
-	if y := d.Year; y < 0 || y >= 10000 {
+	if err := checkYear(d.Year); err != nil {
 		// RFC 3339 is clear that years are 4 digits exactly.
 		// See golang.org/issue/4556#c15 for more discussion.
-		return nil, fmt.Errorf("Date.MarshalJSON: year '%v' outside of range [0,9999]", y)
+		return nil, fmt.Errorf("Date.MarshalJSON: %w", err)
 	}
 
 	b := make([]byte, 0, len(RFC3339Date)+2)
 	b = append(b, '"')
-	b = append(b, d.String()...)
+	b = d.appendExpanded(b)
 	b = append(b, '"')
 	return b, nil
 }
 
-// Value implements the database/sql/driver valuer interface.
+// AppendJSON is like MarshalJSON but appends the quoted date to b and
+// returns the extended buffer. On error b is returned unchanged.
+func (d Date) AppendJSON(b []byte) ([]byte, error) {
+	if err := checkYear(d.Year); err != nil {
+		return b, fmt.Errorf("Date.AppendJSON: %w", err)
+	}
+	b = append(b, '"')
+	b = d.appendExpanded(b)
+	return append(b, '"'), nil
+}
+
+// Value implements the database/sql/driver valuer interface. The date is
+// written as a "2006-01-02" string. Use SQLOptions.Column to write it in
+// another form.
 func (d Date) Value() (driver.Value, error) {
+	return d.value(SQLOptions{})
+}
+
+// value returns the date as written under the options o: as a string, or
+// as selected by the EpochDays and TimeValues fields.
+func (d Date) value(o SQLOptions) (driver.Value, error) {
+	switch {
+	case o.EpochDays:
+		return d.DaysSinceEpoch(), nil
+	case o.TimeValues:
+		return d.In(time.UTC), nil
+	}
 	return d.String(), nil
 }
 
-// Scan implements the database/sql scanner interface.
+// Scan implements the database/sql scanner interface. It accepts string and
+// []byte values, in a format accepted by ParseDate, and time.Time values,
+// also as a *time.Time or sql.NullTime. A NULL is an error wrapping
+// ErrNull; scan nullable columns into a NullDate. PostgreSQL's 'infinity' and '-infinity'
+// are errors wrapping ErrYearOutOfRange. Use SQLOptions.Column to scan
+// with other options.
 func (d *Date) Scan(value interface{}) error {
-	if value == nil {
-		return nil
-	}
+	return d.scan(SQLOptions{}, value)
+}
 
-	str, ok := value.(string)
-	if !ok {
-		t, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("'%s' could not be converted into a valid type", str)
+// scan is like Scan but follows the options o.
+func (d *Date) scan(o SQLOptions, value interface{}) error {
+	value = timeSource(value)
+	if sign := infinitySign(value); sign != 0 {
+		switch o.Infinity {
+		case InfinityClamp:
+			*d = MaxDate()
+			if sign < 0 {
+				*d = MinDate()
+			}
+			return nil
+		case InfinityNull:
+			value = nil
+		default:
+			return fmt.Errorf("%w: cannot scan %s into Date unless SQLOptions.Infinity is set", ErrYearOutOfRange, value)
 		}
-
-		val := DateOf(t)
-		*d = val
-	} else {
-		val, err := ParseDate(str)
-		if err != nil {
-			return err
+	}
+	var val Date
+	switch v := value.(type) {
+	case nil:
+		if !o.NullAsZero {
+			return fmt.Errorf("%w: cannot scan NULL into Date; use NullDate", ErrNull)
+		}
+	case string:
+		var err error
+		if val, err = o.parseDate(v); err != nil {
+			return o.scanParseError(value, "Date", RFC3339Date, err)
+		}
+	case []byte:
+		var err error
+		if val, err = o.parseDate(string(v)); err != nil {
+			return o.scanParseError(value, "Date", RFC3339Date, err)
+		}
+	case time.Time:
+		if !o.scanZero(v) {
+			val = DateOf(v)
+		}
+	case int64:
+		if !o.EpochDays {
+			return fmt.Errorf("%w: cannot scan int64 into Date unless SQLOptions.EpochDays is set", ErrUnsupportedType)
 		}
-		*d = val
+		val = FromEpochDays(v)
+	default:
+		if o.EpochDays {
+			return errScanType(value, "Date", "int64")
+		}
+		return errScanType(value, "Date")
 	}
-
+	*d = val
 	return nil
 }
 
@@ -229,31 +475,226 @@ func TimeOf(t time.Time) Time {
 	return tm
 }
 
+// NewTime returns the time of day with the given fields, or a *FieldError
+// wrapping ErrInvalidTime if any of them is out of range.
+func NewTime(hour, minute, second, nanosecond int) (Time, error) {
+	if err := checkTime(hour, minute, second, nanosecond); err != nil {
+		return Time{}, err
+	}
+	return Time{Hour: hour, Minute: minute, Second: second, Nanosecond: nanosecond}, nil
+}
+
 // RFC3339Time is the civil time format of RFC3339
 const RFC3339Time = "15:04:05.999999999"
 
+// ISO8601BasicTime is the ISO 8601 basic time format, for use with Format
+// and ParseTimeLayout.
+const ISO8601BasicTime = "150405.999999999"
+
 // ParseTime parses a string and returns the time value it represents.
 // ParseTime accepts an extended form of the RFC3339 partial-time format. After
 // the HH:MM:SS part of the string, an optional fractional part may appear,
 // consisting of a decimal point followed by one to nine decimal digits.
-// (RFC3339 admits only one digit after the decimal point).
+// (RFC3339 admits only one digit after the decimal point). As ISO 8601
+// permits, a comma may be used in place of the decimal point. The seconds may
+// also be omitted entirely, as in the HH:MM values sent by HTML time inputs.
+// Errors are of type *ParseError.
 func ParseTime(s string) (Time, error) {
-	t, err := time.Parse(RFC3339Time, s)
+	return ParseOptions{}.ParseTime(s)
+}
+
+// MustParseTime is like ParseTime but panics if s cannot be parsed. It
+// simplifies the initialization of global variables and test fixtures.
+func MustParseTime(s string) Time {
+	t, err := ParseTime(s)
 	if err != nil {
-		return Time{}, err
+		panic(err)
+	}
+	return t
+}
+
+// ParseTimeLayout parses value according to layout, which uses the
+// reference-time notation of time.Parse, and returns the time of day it
+// represents. Layouts such as "15:04" and "3:04 PM" are typical. Any date or
+// zone elements in the layout are parsed but discarded.
+func ParseTimeLayout(layout, value string) (Time, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return Time{}, layoutError(err)
 	}
 	return TimeOf(t), nil
 }
 
+// ParseTimeAny parses value with each of layouts in turn, as ParseTimeLayout
+// does, and returns the time from the first layout that matches together
+// with that layout. If no layout matches, the error lists all of them.
+func ParseTimeAny(value string, layouts ...string) (Time, string, error) {
+	for _, layout := range layouts {
+		if t, err := ParseTimeLayout(layout, value); err == nil {
+			return t, layout, nil
+		}
+	}
+	return Time{}, "", noLayoutMatched(value, layouts)
+}
+
+// Precision is the number of fractional-second digits written when a Time or
+// DateTime is formatted, from 0 to 9, or one of PrecisionAuto and
+// PrecisionMinutes. Extra digits are truncated, not rounded, so the seconds
+// and any larger fields are never changed.
+type Precision int
+
+const (
+	// PrecisionAuto writes no fractional part when the nanoseconds are zero
+	// and nine digits otherwise.
+	PrecisionAuto Precision = -1
+
+	// PrecisionMinutes writes the hour and minute only, as in "15:04",
+	// dropping the seconds and any fractional part.
+	PrecisionMinutes Precision = -2
+
+	PrecisionSeconds Precision = 0 // "15:04:05"
+	PrecisionMillis  Precision = 3 // "15:04:05.000"
+	PrecisionMicros  Precision = 6 // "15:04:05.000000"
+	PrecisionNanos   Precision = 9 // "15:04:05.000000000"
+)
+
 // String returns the date in the format described in ParseTime. If Nanoseconds
 // is zero, no fractional part will be generated. Otherwise, the result will
 // end with a fractional part consisting of a decimal point and nine digits.
+// For a fixed number of digits, use StringPrecision, or the TimeMillis and
+// TimeMicros types for values that are encoded.
 func (t Time) String() string {
-	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
-	if t.Nanosecond == 0 {
-		return s
+	return string(t.appendString(make([]byte, 0, len(RFC3339Time))))
+}
+
+// StringPrecision is like String but always ends with a fractional part of
+// exactly p digits, truncating the nanoseconds as needed. PrecisionSeconds
+// omits the fractional part and PrecisionAuto behaves as String does.
+// Values of p above 9 are treated as 9.
+func (t Time) StringPrecision(p Precision) string {
+	return string(t.appendPrecision(make([]byte, 0, len(RFC3339Time)), p))
+}
+
+func (t Time) appendString(b []byte) []byte {
+	return t.appendPrecision(b, PrecisionAuto)
+}
+
+func (t Time) appendPrecision(b []byte, p Precision) []byte {
+	b = appendInt(b, t.Hour, 2, '0')
+	b = append(b, ':')
+	b = appendInt(b, t.Minute, 2, '0')
+	if p == PrecisionMinutes {
+		return b
+	}
+	b = append(b, ':')
+	b = appendInt(b, t.Second, 2, '0')
+
+	if p < 0 {
+		if t.Nanosecond == 0 {
+			return b
+		}
+		p = PrecisionNanos
+	} else if p > PrecisionNanos {
+		p = PrecisionNanos
+	}
+	if p == PrecisionSeconds {
+		return b
 	}
-	return s + fmt.Sprintf(".%09d", t.Nanosecond)
+	frac := t.Nanosecond
+	for i := p; i < PrecisionNanos; i++ {
+		frac /= 10
+	}
+	b = append(b, '.')
+	return appendInt(b, frac, int(p), '0')
+}
+
+// Format returns a textual representation of the time formatted according
+// to layout, which uses the reference-time notation of time.Time.Format.
+//
+// The hour, minute, second, fractional second and AM/PM elements are
+// supported. Date elements format as January 1 of year 0 and zone elements
+// format as UTC; they should not appear in the layout.
+func (t Time) Format(layout string) string {
+	return t.onDate0().Format(layout)
+}
+
+// AppendFormat is like Format but appends the textual representation to b
+// and returns the extended buffer.
+func (t Time) AppendFormat(b []byte, layout string) []byte {
+	return t.onDate0().AppendFormat(b, layout)
+}
+
+// IsEndOfDay reports whether t is the ISO 8601 end-of-day time 24:00:00,
+// which ParseOptions can accept with EndOfDayKeep. String formats it as
+// "24:00:00". IsValid reports false for it because its hour is outside the
+// range [0-23].
+func (t Time) IsEndOfDay() bool {
+	return t == Time{Hour: 24}
+}
+
+// Format12 returns the hour and minute of the time on a 12-hour clock, as in
+// "03:42 PM". Midnight is "12:00 AM" and noon is "12:00 PM". Seconds are
+// omitted; use Format with a layout such as "03:04:05 PM" to include them.
+func (t Time) Format12() string {
+	return t.Format("03:04 PM")
+}
+
+// ParseTime12 parses a time written on a 12-hour clock, such as "3:42 PM",
+// "03:42:31 pm" or "12:00AM". The hour must be in the range [1,12] and may
+// have one or two digits; the minutes are required and the seconds, with an
+// optional fractional part, are optional. The AM or PM suffix is required,
+// is case-insensitive and may be separated from the clock by a space.
+// "12:00 AM" is midnight and "12:00 PM" is noon.
+func ParseTime12(s string) (Time, error) {
+	n := len(s)
+	if n < 2 {
+		return Time{}, fmt.Errorf("civil: %q is not a 12-hour clock time", s)
+	}
+	var pm bool
+	switch strings.ToUpper(s[n-2:]) {
+	case "AM":
+	case "PM":
+		pm = true
+	default:
+		return Time{}, fmt.Errorf("civil: %q is missing an AM or PM suffix", s)
+	}
+	clock := strings.TrimSuffix(s[:n-2], " ")
+
+	t, err := ParseTime(clock)
+	if err != nil || t.Hour < 1 || t.Hour > 12 {
+		return Time{}, fmt.Errorf("civil: %q is not a 12-hour clock time", s)
+	}
+	t.Hour %= 12
+	if pm {
+		t.Hour += 12
+	}
+	return t, nil
+}
+
+// onDate0 returns the time on January 1 of year 0 in UTC.
+func (t Time) onDate0() time.Time {
+	return time.Date(0, time.January, 1, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC)
+}
+
+// sinceMidnight returns the time elapsed from midnight to t.
+func (t Time) sinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second + time.Duration(t.Nanosecond)
+}
+
+// timeSinceMidnight returns the time of day d after midnight. It returns an
+// error wrapping ErrInvalidTime unless d is in [0,24h], where 24h is the
+// end-of-day time 24:00.
+func timeSinceMidnight(d time.Duration) (Time, error) {
+	if d < 0 || d > 24*time.Hour {
+		return Time{}, fmt.Errorf("%w: %d nanoseconds is not in a day", ErrInvalidTime, int64(d))
+	}
+	return Time{
+		Hour:       int(d / time.Hour),
+		Minute:     int(d % time.Hour / time.Minute),
+		Second:     int(d % time.Minute / time.Second),
+		Nanosecond: int(d % time.Second),
+	}, nil
 }
 
 // IsValid reports whether the time is valid.
@@ -263,29 +704,133 @@ func (t Time) IsValid() bool {
 	return TimeOf(tm) == t
 }
 
+// Normalize returns the valid time that t denotes when its out-of-range
+// fields are rolled over as time.Date does, so that second 75 is 15 seconds
+// into the next minute. Whole days are discarded, so 25:00 becomes 01:00 and
+// the end-of-day time 24:00 becomes 00:00. Normalize also reports whether
+// the result differs from t.
+func (t Time) Normalize() (Time, bool) {
+	n := TimeOf(t.onDate0())
+	return n, n != t
+}
+
+// Sub returns the duration t-u within a single day, which is negative if t
+// is earlier in the day than u. For example, 17:30 minus 09:00 is 8h30m.
+func (t Time) Sub(u Time) time.Duration {
+	return t.sinceMidnight() - u.sinceMidnight()
+}
+
+// DurationSinceMidnight returns the time elapsed from midnight to t, which is
+// 24h for the end-of-day time 24:00.
+func (t Time) DurationSinceMidnight() time.Duration {
+	return t.sinceMidnight()
+}
+
+// Truncate returns the result of rounding t down to a multiple of d since
+// midnight, such as time.Second or time.Minute. If d <= 0, Truncate returns
+// t unchanged.
+func (t Time) Truncate(d time.Duration) Time {
+	if d <= 0 {
+		return t
+	}
+	n := t.sinceMidnight()
+	return clampedTime(n - n%d)
+}
+
+// Round returns the result of rounding t to the nearest multiple of d since
+// midnight, rounding halfway values up. A time that rounds up past the last
+// multiple in the day becomes the end-of-day time 24:00, so 23:59:59.6
+// rounded to the second is 24:00:00. If d <= 0, Round returns t unchanged.
+func (t Time) Round(d time.Duration) Time {
+	if d <= 0 {
+		return t
+	}
+	n := t.sinceMidnight()
+	if r := n % d; r+r < d {
+		n -= r
+	} else {
+		n += d - r
+	}
+	return clampedTime(n)
+}
+
+// clampedTime returns the time of day d after midnight, limited to the
+// end-of-day time 24:00 so that a leap second rounds to the end of the day.
+func clampedTime(d time.Duration) Time {
+	if d > 24*time.Hour {
+		d = 24 * time.Hour
+	}
+	t, _ := timeSinceMidnight(d)
+	return t
+}
+
+// NanosOfDay returns the number of nanoseconds since midnight, as stored by
+// Arrow time64[ns] and Parquet TIME(NANOS) columns.
+func (t Time) NanosOfDay() int64 {
+	return int64(t.sinceMidnight())
+}
+
+// TimeFromNanosOfDay returns the time of day n nanoseconds after midnight. It
+// returns an error wrapping ErrInvalidTime unless n is in [0,86400e9], where
+// 86400e9 is the end-of-day time 24:00.
+func TimeFromNanosOfDay(n int64) (Time, error) {
+	return timeSinceMidnight(time.Duration(n))
+}
+
+// Add returns the time of day d after t, wrapping around midnight, and the
+// number of days carried, which is negative if d goes back past midnight.
+// For example, 23:00 plus 90 minutes is 00:30 with one day carried. The
+// end-of-day time 24:00 is treated as midnight of the next day.
+func (t Time) Add(d time.Duration) (Time, int) {
+	const day = int64(24 * time.Hour)
+	days := floorDiv(int64(d), day)
+	rest := int64(t.sinceMidnight()) + floorMod(int64(d), day)
+	days += floorDiv(rest, day)
+	u, _ := timeSinceMidnight(time.Duration(floorMod(rest, day)))
+	return u, int(days)
+}
+
 // MarshalText implements the encoding.TextMarshaler interface.
 // The output is the result of t.String().
 func (t Time) MarshalText() ([]byte, error) {
 	return []byte(t.String()), nil
 }
 
+// AppendText implements the encoding.TextAppender interface.
+// It appends the result of t.String() to b.
+func (t Time) AppendText(b []byte) ([]byte, error) {
+	return t.appendString(b), nil
+}
+
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
 // The time is expected to be a string in a format accepted by ParseTime.
 func (t *Time) UnmarshalText(data []byte) error {
+	return t.unmarshalText(ParseOptions{}, data)
+}
+
+func (t *Time) unmarshalText(o ParseOptions, data []byte) error {
 	var err error
-	*t, err = ParseTime(string(data))
+	*t, err = o.decoding().ParseTime(string(data))
 	return err
 }
 
-// UnmarshalJSON implements encoding/json Unmarshaler interface
+// UnmarshalJSON implements encoding/json Unmarshaler interface. As for
+// time.Time, the JSON null value leaves the time unchanged.
 func (t *Time) UnmarshalJSON(data []byte) error {
+	return t.unmarshalJSON(ParseOptions{}, data)
+}
+
+func (t *Time) unmarshalJSON(o ParseOptions, data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
 	var s string
 	if err := json.Unmarshal(data, &s); err != nil {
-		return fmt.Errorf("time should be a string, got %s", data)
+		return fmt.Errorf("%w: time should be a string, got %s", ErrInvalidFormat, data)
 	}
-	val, err := ParseTime(s)
+	val, err := o.decoding().ParseTime(s)
 	if err != nil {
-		return fmt.Errorf("invalid time: %v", err)
+		return fmt.Errorf("invalid time: %w", err)
 	}
 	*t = val
 	return nil
@@ -293,41 +838,68 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 
 // MarshalJSON implements encoding/json Marshaler interface
 func (t *Time) MarshalJSON() ([]byte, error) {
-	b := make([]byte, 0, len(RFC3339Time)+2)
-	b = append(b, '"')
-	b = append(b, t.String()...)
+	return t.AppendJSON(make([]byte, 0, len(RFC3339Time)+2))
+}
+
+// AppendJSON is like MarshalJSON but appends the quoted time to b and
+// returns the extended buffer.
+func (t Time) AppendJSON(b []byte) ([]byte, error) {
 	b = append(b, '"')
-	return b, nil
+	b = t.appendString(b)
+	return append(b, '"'), nil
 }
 
-// Value implements the database/sql/driver valuer interface.
+// Value implements the database/sql/driver valuer interface. The time is
+// written as a string. Use SQLOptions.Column to write it for another
+// dialect.
 func (t Time) Value() (driver.Value, error) {
+	return t.value(SQLOptions{})
+}
+
+// value returns the time as written under the options o: as a string,
+// limited to microseconds under DialectMySQL and to 100ns ticks under
+// DialectSQLServer.
+func (t Time) value(o SQLOptions) (driver.Value, error) {
+	if p, ok := o.valuePrecision(); ok {
+		return t.StringPrecision(p), nil
+	}
 	return t.String(), nil
 }
 
-// Scan implements the database/sql scanner interface.
+// Scan implements the database/sql scanner interface. It accepts string and
+// []byte values, in a format accepted by ParseTime, and time.Time values,
+// also as a *time.Time or sql.NullTime. A NULL is an error wrapping
+// ErrNull; scan nullable columns into a NullTime. Use SQLOptions.Column to scan with
+// other options.
 func (t *Time) Scan(value interface{}) error {
-	if value == nil {
-		return nil
-	}
+	return t.scan(SQLOptions{}, value)
+}
 
-	str, ok := value.(string)
-	if !ok {
-		tm, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("'%s' could not be converted into a valid type", str)
+// scan is like Scan but follows the options o.
+func (t *Time) scan(o SQLOptions, value interface{}) error {
+	value = timeSource(value)
+	var val Time
+	switch v := value.(type) {
+	case nil:
+		if !o.NullAsZero {
+			return fmt.Errorf("%w: cannot scan NULL into Time; use NullTime", ErrNull)
 		}
-
-		val := TimeOf(tm)
-		*t = val
-	} else {
-		val, err := ParseTime(str)
-		if err != nil {
-			return err
+	case string:
+		var err error
+		if val, err = o.Parse.decoding().ParseTime(v); err != nil {
+			return o.scanParseError(value, "Time", RFC3339Time, err)
+		}
+	case []byte:
+		var err error
+		if val, err = o.Parse.decoding().ParseTime(string(v)); err != nil {
+			return o.scanParseError(value, "Time", RFC3339Time, err)
 		}
-		*t = val
+	case time.Time:
+		val = TimeOf(v)
+	default:
+		return errScanType(value, "Time")
 	}
-
+	*t = val
 	return nil
 }
 
@@ -350,29 +922,125 @@ func DateTimeOf(t time.Time) DateTime {
 	}
 }
 
+// NewDateTime returns the DateTime with the given fields, or an error as
+// returned by NewDate or NewTime if they do not form a valid date and time.
+func NewDateTime(year int, month time.Month, day, hour, minute, second, nanosecond int) (DateTime, error) {
+	d, err := NewDate(year, month, day)
+	if err != nil {
+		return DateTime{}, err
+	}
+	t, err := NewTime(hour, minute, second, nanosecond)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTime{Date: d, Time: t}, nil
+}
+
 // RFC3339Time is the civil datetime format of RFC3339
 const RFC3339DateTime = "2006-01-02T15:04:05.999999999"
 
+// ISO8601BasicDateTime is the ISO 8601 basic datetime format, for use with
+// Format and ParseDateTimeLayout.
+const ISO8601BasicDateTime = ISO8601BasicDate + "T" + ISO8601BasicTime
+
 // ParseDateTime parses a string and returns the DateTime it represents.
 // ParseDateTime accepts a variant of the RFC3339 date-time format that omits
 // the time offset but includes an optional fractional time, as described in
 // ParseTime. Informally, the accepted format is
-//     YYYY-MM-DDTHH:MM:SS[.FFFFFFFFF]
-// where the 'T' may be a lower-case 't'.
+//     YYYY-MM-DDTHH:MM[:SS[.FFFFFFFFF]]
+// where the 'T' may be a lower-case 't' and the '.' may be a ','. The date
+// part is parsed as by ParseDate, so the zero date "0000-00-00" is accepted.
 func ParseDateTime(s string) (DateTime, error) {
-	t, err := time.Parse(RFC3339DateTime, s)
+	return ParseOptions{}.ParseDateTime(s)
+}
+
+// MustParseDateTime is like ParseDateTime but panics if s cannot be parsed.
+// It simplifies the initialization of global variables and test fixtures.
+func MustParseDateTime(s string) DateTime {
+	dt, err := ParseDateTime(s)
 	if err != nil {
-		t, err = time.Parse("2006-01-02t15:04:05.999999999", s)
-		if err != nil {
-			return DateTime{}, err
-		}
+		panic(err)
+	}
+	return dt
+}
+
+// ParseDateTimeLayout parses value according to layout, which uses the
+// reference-time notation of time.Parse, and returns the DateTime it
+// represents. For example, the layout "2006-01-02 15:04:05" accepts the
+// space-separated form used by many databases. Any zone elements in the
+// layout are parsed but discarded; the result is the wall-clock time as
+// written.
+func ParseDateTimeLayout(layout, value string) (DateTime, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return DateTime{}, layoutError(err)
 	}
 	return DateTimeOf(t), nil
 }
 
+// ParseDateTimeAny parses value with each of layouts in turn, as
+// ParseDateTimeLayout does, and returns the datetime from the first layout
+// that matches together with that layout. If no layout matches, the error
+// lists all of them.
+func ParseDateTimeAny(value string, layouts ...string) (DateTime, string, error) {
+	for _, layout := range layouts {
+		if dt, err := ParseDateTimeLayout(layout, value); err == nil {
+			return dt, layout, nil
+		}
+	}
+	return DateTime{}, "", noLayoutMatched(value, layouts)
+}
+
 // String returns the date in the format described in ParseDate.
 func (dt DateTime) String() string {
-	return dt.Date.String() + "T" + dt.Time.String()
+	return string(dt.appendString(make([]byte, 0, len(RFC3339DateTime))))
+}
+
+// StringPrecision is like String but formats the time with the given
+// precision, as described in Time.StringPrecision.
+func (dt DateTime) StringPrecision(p Precision) string {
+	return string(dt.appendPrecision(make([]byte, 0, len(RFC3339DateTime)), p))
+}
+
+func (dt DateTime) appendString(b []byte) []byte {
+	return dt.appendPrecision(b, PrecisionAuto)
+}
+
+func (dt DateTime) appendPrecision(b []byte, p Precision) []byte {
+	b = dt.Date.appendString(b)
+	b = append(b, 'T')
+	return dt.Time.appendPrecision(b, p)
+}
+
+// ExpandedString is like String but writes the date as Date.ExpandedString
+// does, so a year outside [0,9999] has a leading sign and at least four
+// digits.
+func (dt DateTime) ExpandedString() string {
+	return string(dt.appendExpanded(make([]byte, 0, len("+12020-03-04T15:04:05.999999999"))))
+}
+
+func (dt DateTime) appendExpanded(b []byte) []byte {
+	return dt.appendExpandedPrecision(b, PrecisionAuto)
+}
+
+func (dt DateTime) appendExpandedPrecision(b []byte, p Precision) []byte {
+	b = dt.Date.appendExpanded(b)
+	b = append(b, 'T')
+	return dt.Time.appendPrecision(b, p)
+}
+
+// Format returns a textual representation of the datetime formatted
+// according to layout, which uses the reference-time notation of
+// time.Time.Format. Zone elements format as UTC and should not appear in the
+// layout.
+func (dt DateTime) Format(layout string) string {
+	return dt.In(time.UTC).Format(layout)
+}
+
+// AppendFormat is like Format but appends the textual representation to b
+// and returns the extended buffer.
+func (dt DateTime) AppendFormat(b []byte, layout string) []byte {
+	return dt.In(time.UTC).AppendFormat(b, layout)
 }
 
 // IsValid reports whether the datetime is valid.
@@ -380,6 +1048,16 @@ func (dt DateTime) IsValid() bool {
 	return dt.Date.IsValid() && dt.Time.IsValid()
 }
 
+// Normalize returns the valid DateTime that dt denotes when its
+// out-of-range fields are rolled over as time.Date does. Unlike
+// Time.Normalize, overflowing times carry into the date, so 2020-02-29T24:00
+// becomes 2020-03-01T00:00. It also reports whether the result differs
+// from dt.
+func (dt DateTime) Normalize() (DateTime, bool) {
+	n := DateTimeOf(dt.In(time.UTC))
+	return n, n != dt
+}
+
 // In returns the time corresponding to the DateTime in the given location.
 //
 // If the time is missing or ambiguous at the location, In returns the same
@@ -407,78 +1085,242 @@ func (dt1 DateTime) After(dt2 DateTime) bool {
 	return dt2.Before(dt1)
 }
 
+// Add returns the datetime dt+d, carrying into the date as needed. Days are
+// always 24 hours long, since a DateTime has no location and so no
+// daylight saving transitions.
+func (dt DateTime) Add(d time.Duration) DateTime {
+	return DateTimeOf(dt.In(time.UTC).Add(d))
+}
+
+// Truncate returns the result of rounding dt down to a multiple of d since
+// midnight, such as time.Millisecond, time.Second, time.Minute or time.Hour.
+// A d of 24 hours or more truncates to midnight at the start of the day. If
+// d <= 0, Truncate returns dt unchanged.
+func (dt DateTime) Truncate(d time.Duration) DateTime {
+	if d <= 0 {
+		return dt
+	}
+	if d > 24*time.Hour {
+		d = 24 * time.Hour
+	}
+	dt, _ = dt.Normalize()
+	dt.Time = dt.Time.Truncate(d)
+	return dt
+}
+
+// Round returns the result of rounding dt to the nearest multiple of d since
+// midnight, rounding halfway values up and carrying into the next day as
+// needed. A d of 24 hours or more rounds to the nearest midnight. If d <= 0,
+// Round returns dt unchanged.
+func (dt DateTime) Round(d time.Duration) DateTime {
+	if d <= 0 {
+		return dt
+	}
+	if d > 24*time.Hour {
+		d = 24 * time.Hour
+	}
+	dt, _ = dt.Normalize()
+	dt.Time = dt.Time.Round(d)
+	dt, _ = dt.Normalize()
+	return dt
+}
+
+// RoundToDate returns the date nearest to dt, which is the next day from
+// noon onwards.
+func (dt DateTime) RoundToDate() Date {
+	return dt.Round(24 * time.Hour).Date
+}
+
+// Sub returns the duration dt-u, treating both as being in the same
+// location with 24-hour days. A time.Duration can only hold about 292
+// years, so if the result would overflow Sub returns the maximum (or
+// minimum) duration instead, as time.Time's Sub does. Use DaysSince or
+// Date.Sub for longer spans.
+func (dt DateTime) Sub(u DateTime) time.Duration {
+	return dt.In(time.UTC).Sub(u.In(time.UTC))
+}
+
 // MarshalText implements the encoding.TextMarshaler interface.
-// The output is the result of dt.String().
+// The output is the result of dt.ExpandedString(). It returns an error
+// wrapping ErrYearOutOfRange if the year is outside [MinYear,MaxYear].
 func (dt DateTime) MarshalText() ([]byte, error) {
-	return []byte(dt.String()), nil
+	if err := checkYear(dt.Date.Year); err != nil {
+		return nil, fmt.Errorf("DateTime.MarshalText: %w", err)
+	}
+	return []byte(dt.ExpandedString()), nil
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the result of dt.ExpandedString() to b. On error b is returned
+// unchanged.
+func (dt DateTime) AppendText(b []byte) ([]byte, error) {
+	if err := checkYear(dt.Date.Year); err != nil {
+		return b, fmt.Errorf("DateTime.AppendText: %w", err)
+	}
+	return dt.appendExpanded(b), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
-// The datetime is expected to be a string in a format accepted by ParseDateTime
+// The datetime is expected to be a string in a format accepted by
+// ParseDateTime. Use ParseOptions.Target to accept other formats.
 func (dt *DateTime) UnmarshalText(data []byte) error {
+	return dt.unmarshalText(ParseOptions{}, data)
+}
+
+func (dt *DateTime) unmarshalText(o ParseOptions, data []byte) error {
 	var err error
-	*dt, err = ParseDateTime(string(data))
+	*dt, err = o.decoding().ParseDateTime(string(data))
 	return err
 }
 
-// UnmarshalJSON implements encoding/json Unmarshaler interface
+// UnmarshalJSON implements encoding/json Unmarshaler interface. As for
+// time.Time, the JSON null value leaves the datetime unchanged. To also
+// accept the "2020-02-29 03:42:31" form written by MySQL and many logging
+// pipelines, use a DateTimeSQL or ParseOptions.Target with SpaceSeparator
+// set.
 func (dt *DateTime) UnmarshalJSON(data []byte) error {
-	tIdx := bytes.IndexAny(data, "Tt")
-	if tIdx < 10 {
-		return fmt.Errorf("data is not valid DateTime value: %s", string(data))
-	}
-
-	dataDate := data[:tIdx+1] // `"` to the `T`
-	dataDate[tIdx] = byte('"')
-	dataTime := data[tIdx:] // `T` to the end `"`
-	dataTime[0] = byte('"')
+	return dt.unmarshalJSON(ParseOptions{}, data)
+}
 
-	if err := dt.Date.UnmarshalJSON(dataDate); err != nil {
-		return errors.Wrapf(err, "date prefix (%s) in '%s' could not be converted", dataDate, data)
+func (dt *DateTime) unmarshalJSON(o ParseOptions, data []byte) error {
+	if string(data) == "null" {
+		return nil
 	}
-	if err := dt.Time.UnmarshalJSON(dataTime); err != nil {
-		return errors.Wrapf(err, "time suffix (%s) in '%s' could not be converted", dataTime, data)
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%w: datetime should be a string, got %s", ErrInvalidFormat, data)
+	}
+	val, err := o.decoding().ParseDateTime(s)
+	if err != nil {
+		return errors.Wrapf(err, "invalid datetime: %s", s)
 	}
+	*dt = val
 	return nil
-
 }
 
 // MarshalJSON implements encoding/json Marshaler interface
 func (dt *DateTime) MarshalJSON() ([]byte, error) {
-	b := make([]byte, 0, len(RFC3339DateTime)+2)
-	b = append(b, '"')
-	b = append(b, dt.String()...)
+	if err := checkYear(dt.Date.Year); err != nil {
+		return nil, fmt.Errorf("DateTime.MarshalJSON: %w", err)
+	}
+	return dt.AppendJSON(make([]byte, 0, len("+12020-03-04T15:04:05.999999999")+2))
+}
+
+// AppendJSON is like MarshalJSON but appends the quoted datetime to b and
+// returns the extended buffer. On error b is returned unchanged.
+func (dt DateTime) AppendJSON(b []byte) ([]byte, error) {
+	if err := checkYear(dt.Date.Year); err != nil {
+		return b, fmt.Errorf("DateTime.AppendJSON: %w", err)
+	}
 	b = append(b, '"')
-	return b, nil
+	b = dt.appendExpanded(b)
+	return append(b, '"'), nil
 }
 
-// Value implements the database/sql/driver valuer interface.
+// Value implements the database/sql/driver valuer interface. The datetime
+// is written as a string in the format of String. Use SQLOptions.Column to
+// write it in another form.
 func (dt DateTime) Value() (driver.Value, error) {
+	return dt.value(SQLOptions{})
+}
+
+// value returns the datetime as written under the options o: as a string,
+// or as a time.Time in UTC if o.TimeValues is set. Under DialectMySQL the
+// string is a DATETIME(6) literal such as "2006-01-02 15:04:05.000000",
+// and under DialectSQLServer a DATETIME2 literal such as
+// "2006-01-02 15:04:05.0000000".
+func (dt DateTime) value(o SQLOptions) (driver.Value, error) {
+	if o.TimeValues {
+		return dt.In(time.UTC), nil
+	}
+	if p, ok := o.valuePrecision(); ok {
+		b := make([]byte, 0, len("2006-01-02 15:04:05.0000000"))
+		b = dt.Date.appendString(b)
+		b = append(b, ' ')
+		return string(dt.Time.appendPrecision(b, p)), nil
+	}
 	return dt.String(), nil
 }
 
-// Scan implements the database/sql scanner interface.
+// Scan implements the database/sql scanner interface. It accepts string and
+// []byte values, in a format accepted by ParseDateTime or with the space
+// separator of SQL literals, and time.Time values, also as a *time.Time or
+// sql.NullTime. A NULL is an error wrapping ErrNull; scan nullable columns
+// into a NullDateTime. PostgreSQL's 'infinity' and '-infinity' are errors
+// wrapping ErrYearOutOfRange. Use SQLOptions.Column to scan with other
+// options.
 func (dt *DateTime) Scan(value interface{}) error {
-	if value == nil {
-		return nil
-	}
+	return dt.scan(SQLOptions{}, value)
+}
 
-	str, ok := value.(string)
-	if !ok {
-		t, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("'%s' could not be converted into a valid type", str)
+// scan is like Scan but follows the options o.
+func (dt *DateTime) scan(o SQLOptions, value interface{}) error {
+	value = timeSource(value)
+	if sign := infinitySign(value); sign != 0 {
+		switch o.Infinity {
+		case InfinityClamp:
+			*dt = MaxDateTime()
+			if sign < 0 {
+				*dt = MinDateTime()
+			}
+			return nil
+		case InfinityNull:
+			value = nil
+		default:
+			return fmt.Errorf("%w: cannot scan %s into DateTime unless SQLOptions.Infinity is set", ErrYearOutOfRange, value)
 		}
-
-		val := DateTimeOf(t)
-		*dt = val
-	} else {
-		val, err := ParseDateTime(str)
-		if err != nil {
-			return err
+	}
+	var val DateTime
+	switch v := value.(type) {
+	case nil:
+		if !o.NullAsZero {
+			return fmt.Errorf("%w: cannot scan NULL into DateTime; use NullDateTime", ErrNull)
+		}
+	case string:
+		var err error
+		if val, err = o.parseDateTime(v); err != nil {
+			return o.scanParseError(value, "DateTime", RFC3339DateTime, err)
+		}
+	case []byte:
+		var err error
+		if val, err = o.parseDateTime(string(v)); err != nil {
+			return o.scanParseError(value, "DateTime", RFC3339DateTime, err)
+		}
+	case time.Time:
+		if !o.scanZero(v) {
+			val = DateTimeOf(v)
 		}
-		*dt = val
+	default:
+		return errScanType(value, "DateTime")
 	}
-
+	*dt = val
 	return nil
 }
+
+// appendInt appends the decimal form of x, left-padded with pad to at least
+// width characters, in the manner of fmt's "%0*d". A negative x is written
+// with a leading '-' that counts towards the width.
+func appendInt(b []byte, x int, width int, pad byte) []byte {
+	u := uint(x)
+	if x < 0 {
+		b = append(b, '-')
+		u = uint(-x)
+		width--
+	}
+
+	var buf [20]byte
+	i := len(buf)
+	for u >= 10 {
+		i--
+		q := u / 10
+		buf[i] = byte('0' + u - q*10)
+		u = q
+	}
+	i--
+	buf[i] = byte('0' + u)
+
+	for w := len(buf) - i; w < width; w++ {
+		b = append(b, pad)
+	}
+	return append(b, buf[i:]...)
+}
@@ -22,6 +22,9 @@
 package civil
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -118,10 +121,146 @@ func TestDate_MarshalJSON(t *testing.T) {
 	}
 
 	json, err = dInvalid.MarshalJSON()
-	assert.EqualError(t, err, "Date.MarshalJSON: year '-1' outside of range [0,9999]")
+	assert.EqualError(t, err, "Date.MarshalJSON: civil: year out of range: year -1 is not in [0,9999]")
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
 	assert.Nil(t, json)
 }
 
+func TestYearRange(t *testing.T) {
+	defer func(min, max int) { MinYear, MaxYear = min, max }(MinYear, MaxYear)
+
+	d := Date{12020, 3, 4}
+	_, err := d.MarshalJSON()
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+	assert.Error(t, d.Validate())
+
+	MaxYear = 99999
+	json, err := d.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"+12020-03-04"`, string(json))
+	assert.NoError(t, d.Validate())
+	_, err = NewDate(12020, 3, 4)
+	assert.NoError(t, err)
+
+	MinYear = 1900
+	d = Date{1899, 12, 31}
+	_, err = d.MarshalJSON()
+	assert.EqualError(t, err, "Date.MarshalJSON: civil: year out of range: year 1899 is not in [1900,99999]")
+	_, err = NewDate(1899, 12, 31)
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+}
+
+func TestDateTime_YearRange(t *testing.T) {
+	defer func(min, max int) { MinYear, MaxYear = min, max }(MinYear, MaxYear)
+
+	MaxYear = 2000
+	dt := DateTime{Date{2020, 3, 4}, Time{15, 4, 5, 0}}
+	json, err := dt.MarshalJSON()
+	assert.EqualError(t, err, "DateTime.MarshalJSON: civil: year out of range: year 2020 is not in [0,2000]")
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+	assert.Nil(t, json)
+	text, err := dt.MarshalText()
+	assert.EqualError(t, err, "DateTime.MarshalText: civil: year out of range: year 2020 is not in [0,2000]")
+	assert.Nil(t, text)
+
+	prefix := []byte("x")
+	b, err := dt.AppendJSON(prefix)
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+	assert.Equal(t, "x", string(b))
+	b, err = dt.AppendText(prefix)
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+	assert.Equal(t, "x", string(b))
+
+	MaxYear = 9999
+	json, err = dt.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"2020-03-04T15:04:05"`, string(json))
+}
+
+func TestDate_ExpandedString(t *testing.T) {
+	type TC struct {
+		In  Date
+		Out string
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 3, 4}, Out: "2020-03-04"},
+		TC{In: Date{0, 1, 1}, Out: "0000-01-01"},
+		TC{In: Date{12020, 3, 4}, Out: "+12020-03-04"},
+		TC{In: Date{10000, 1, 1}, Out: "+10000-01-01"},
+		TC{In: Date{-44, 3, 15}, Out: "-0044-03-15"},
+		TC{In: Date{-12345, 6, 7}, Out: "-12345-06-07"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.ExpandedString())
+		d, err := ParseOptions{ExpandedYears: true}.ParseDate(tc.Out)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.In, d)
+	}
+
+	dt := DateTime{Date{-44, 3, 15}, Time{12, 30, 0, 0}}
+	assert.Equal(t, "-0044-03-15T12:30:00", dt.ExpandedString())
+}
+
+func TestDate_JSON_ExpandedYears(t *testing.T) {
+	defer func(min, max int) { MinYear, MaxYear = min, max }(MinYear, MaxYear)
+	MinYear, MaxYear = -99999, 99999
+
+	for _, d := range []Date{{-44, 3, 15}, {12020, 3, 4}, {2020, 3, 4}} {
+		json, err := d.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Equal(t, `"`+d.ExpandedString()+`"`, string(json))
+
+		var got Date
+		assert.NoError(t, got.UnmarshalJSON(json))
+		assert.Equal(t, d, got)
+	}
+}
+
+func TestDate_Text_ExpandedYears(t *testing.T) {
+	defer func(min, max int) { MinYear, MaxYear = min, max }(MinYear, MaxYear)
+	MinYear, MaxYear = -99999, 99999
+
+	for _, d := range []Date{{-44, 3, 15}, {12020, 3, 4}, {2020, 3, 4}} {
+		text, err := d.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, d.ExpandedString(), string(text))
+
+		var got Date
+		assert.NoError(t, got.UnmarshalText(text))
+		assert.Equal(t, d, got)
+	}
+}
+
+func TestDateTime_ExpandedYears(t *testing.T) {
+	defer func(min, max int) { MinYear, MaxYear = min, max }(MinYear, MaxYear)
+	MinYear, MaxYear = -99999, 99999
+
+	type TC struct {
+		In  DateTime
+		Out string
+	}
+	tcs := []TC{
+		TC{In: DateTime{Date{-44, 3, 15}, Time{12, 30, 0, 0}}, Out: "-0044-03-15T12:30:00"},
+		TC{In: DateTime{Date{12020, 3, 4}, Time{15, 4, 5, 0}}, Out: "+12020-03-04T15:04:05"},
+		TC{In: DateTime{Date{2020, 3, 4}, Time{15, 4, 5, 0}}, Out: "2020-03-04T15:04:05"},
+	}
+	for _, tc := range tcs {
+		text, err := tc.In.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.Out, string(text))
+		var got DateTime
+		assert.NoError(t, got.UnmarshalText(text))
+		assert.Equal(t, tc.In, got)
+
+		json, err := tc.In.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Equal(t, `"`+tc.Out+`"`, string(json))
+		got = DateTime{}
+		assert.NoError(t, got.UnmarshalJSON(json))
+		assert.Equal(t, tc.In, got)
+	}
+}
+
 func TestDate_UnmarshalJSON(t *testing.T) {
 	jsonLeap := []byte(`"2020-02-29"`)
 	dLeap := &Date{}
@@ -135,6 +274,156 @@ func TestDate_UnmarshalJSON(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestDate_Format(t *testing.T) {
+	d := Date{Year: 2020, Month: 2, Day: 29}
+
+	assert.Equal(t, "29 Feb 2020", d.Format("02 Jan 2006"))
+	assert.Equal(t, "Saturday, February 29, 2020", d.Format("Monday, January 2, 2006"))
+	assert.Equal(t, "2/29/20", d.Format("1/2/06"))
+	assert.Equal(t, "060", d.Format("002"))
+	assert.Equal(t, d.String(), d.Format(RFC3339Date))
+}
+
+func TestParseDateLayout(t *testing.T) {
+	d, err := ParseDateLayout("01/02/2006", "02/29/2020")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{Year: 2020, Month: 2, Day: 29}, d)
+
+	d, err = ParseDateLayout("2-Jan-2006", "4-Mar-2020")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{Year: 2020, Month: 3, Day: 4}, d)
+
+	_, err = ParseDateLayout("01/02/2006", "02/30/2020")
+	assert.Error(t, err)
+
+	_, err = ParseDateLayout("01/02/2006", "2020-02-29")
+	assert.Error(t, err)
+}
+
+func TestDate_ISO8601Basic(t *testing.T) {
+	d, err := ParseDateLayout(ISO8601BasicDate, "20200229")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{Year: 2020, Month: 2, Day: 29}, d)
+	assert.Equal(t, "20200229", d.Format(ISO8601BasicDate))
+
+	_, err = ParseDateLayout(ISO8601BasicDate, "20200230")
+	assert.Error(t, err)
+	_, err = ParseDateLayout(ISO8601BasicDate, "2020-02-29")
+	assert.Error(t, err)
+}
+
+func TestParseDateAny(t *testing.T) {
+	layouts := []string{RFC3339Date, "01/02/2006", "2-Jan-2006"}
+
+	d, layout, err := ParseDateAny("02/29/2020", layouts...)
+	assert.NoError(t, err)
+	assert.Equal(t, Date{Year: 2020, Month: 2, Day: 29}, d)
+	assert.Equal(t, "01/02/2006", layout)
+
+	d, layout, err = ParseDateAny("4-Mar-2020", layouts...)
+	assert.NoError(t, err)
+	assert.Equal(t, Date{Year: 2020, Month: 3, Day: 4}, d)
+	assert.Equal(t, "2-Jan-2006", layout)
+
+	_, layout, err = ParseDateAny("2020.03.04", layouts...)
+	assert.EqualError(t, err, `civil: "2020.03.04" does not match any of the layouts ["2006-01-02" "01/02/2006" "2-Jan-2006"]`)
+	assert.Equal(t, "", layout)
+
+	_, _, err = ParseDateAny("2020-03-04")
+	assert.Error(t, err)
+}
+
+func TestDate_Append(t *testing.T) {
+	d := Date{Year: 2020, Month: 2, Day: 29}
+	prefix := []byte("day=")
+
+	assert.Equal(t, []byte("day=29 Feb 2020"), d.AppendFormat(prefix, "02 Jan 2006"))
+
+	b, err := d.AppendText(prefix)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("day=2020-02-29"), b)
+
+	b, err = d.AppendJSON(prefix)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`day="2020-02-29"`), b)
+
+	b, err = Date{Year: 10000, Month: 1, Day: 1}.AppendJSON(prefix)
+	assert.Error(t, err)
+	assert.Equal(t, prefix, b)
+}
+
+func TestNewDate(t *testing.T) {
+	type TC struct {
+		Year  int
+		Month time.Month
+		Day   int
+		Err   error
+	}
+	tcs := []TC{
+		TC{Year: 2020, Month: 2, Day: 29},
+		TC{Year: 0, Month: 1, Day: 1},
+		/* === ERRORS === */
+		TC{Year: -44, Month: 3, Day: 15, Err: ErrYearOutOfRange},
+		TC{Year: 10000, Month: 1, Day: 1, Err: ErrYearOutOfRange},
+		TC{Year: 2021, Month: 2, Day: 29, Err: ErrInvalidDay},
+		TC{Year: 2021, Month: 4, Day: 0, Err: ErrInvalidDay},
+		TC{Year: 2021, Month: 0, Day: 1, Err: ErrInvalidMonth},
+		TC{Year: 2021, Month: 13, Day: 1, Err: ErrInvalidMonth},
+	}
+	for _, tc := range tcs {
+		d, err := NewDate(tc.Year, tc.Month, tc.Day)
+		if tc.Err != nil {
+			assert.True(t, errors.Is(err, tc.Err), "%v", err)
+			assert.Equal(t, Date{}, d)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, Date{tc.Year, tc.Month, tc.Day}, d)
+	}
+
+	_, err := NewDate(2021, 2, 29)
+	assert.EqualError(t, err, "civil: invalid day: day 29 is not in [1,28]")
+}
+
+func TestDate_Normalize(t *testing.T) {
+	type TC struct {
+		In      Date
+		Out     Date
+		Changed bool
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 2, 29}, Out: Date{2020, 2, 29}},
+		TC{In: Date{2020, 13, 1}, Out: Date{2021, 1, 1}, Changed: true},
+		TC{In: Date{2020, 10, 32}, Out: Date{2020, 11, 1}, Changed: true},
+		TC{In: Date{2021, 2, 29}, Out: Date{2021, 3, 1}, Changed: true},
+		TC{In: Date{2020, 3, 0}, Out: Date{2020, 2, 29}, Changed: true},
+		TC{In: Date{2020, 0, 1}, Out: Date{2019, 12, 1}, Changed: true},
+	}
+	for _, tc := range tcs {
+		d, changed := tc.In.Normalize()
+		assert.Equal(t, tc.Out, d, "%v", tc.In)
+		assert.Equal(t, tc.Changed, changed, "%v", tc.In)
+		assert.True(t, d.IsValid())
+	}
+}
+
+func TestMustParseDate(t *testing.T) {
+	assert.Equal(t, Date{2020, 2, 29}, MustParseDate("2020-02-29"))
+	func() {
+		defer func() {
+			err, _ := recover().(error)
+			assert.True(t, errors.Is(err, ErrInvalidDay), "%v", err)
+		}()
+		MustParseDate("2021-02-29")
+	}()
+}
+
+func TestDate_String(t *testing.T) {
+	for _, d := range []Date{{2020, 2, 29}, {0, 0, 0}, {7, 1, 2}, {-5, 1, 2}, {12345, 13, -1}} {
+		assert.Equal(t, fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day), d.String())
+	}
+}
+
 func TestDate_AddMonths(t *testing.T) {
 	dLeap := Date{
 		Year:  2020,
@@ -159,6 +448,54 @@ func TestDate_AddYears(t *testing.T) {
 	assert.Equal(t, Date{Year: 2021, Month: 3, Day: 1}, dLeap) // no leap day in 2021, so pushes over to 3/1
 }
 
+func TestDate_AddDate(t *testing.T) {
+	type TC struct {
+		In                  Date
+		Years, Months, Days int
+		Out                 Date
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 1, 31}, Years: 0, Months: 1, Days: -2, Out: Date{2020, 2, 29}},
+		TC{In: Date{2020, 2, 29}, Years: 1, Months: 1, Days: 0, Out: Date{2021, 3, 29}},
+		TC{In: Date{2020, 2, 29}, Years: 1, Months: 0, Days: 0, Out: Date{2021, 3, 1}},
+		TC{In: Date{2020, 2, 29}, Years: 1, Months: 0, Days: -1, Out: Date{2021, 2, 28}},
+		TC{In: Date{2020, 12, 31}, Years: -1, Months: 2, Days: 1, Out: Date{2020, 3, 3}},
+		TC{In: Date{2020, 6, 15}, Years: 0, Months: 0, Days: 0, Out: Date{2020, 6, 15}},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.AddDate(tc.Years, tc.Months, tc.Days), "%v + %dy%dm%dd", tc.In, tc.Years, tc.Months, tc.Days)
+	}
+	assert.Equal(t, Date{2021, 4, 1}, Date{2020, 2, 29}.AddYears(1).AddMonths(1))
+}
+
+func TestDate_AddMonthsClamped(t *testing.T) {
+	type TC struct {
+		In     Date
+		Months int
+		Out    Date
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 1, 31}, Months: 1, Out: Date{2020, 2, 29}},
+		TC{In: Date{2021, 1, 31}, Months: 1, Out: Date{2021, 2, 28}},
+		TC{In: Date{2020, 1, 31}, Months: 3, Out: Date{2020, 4, 30}},
+		TC{In: Date{2020, 1, 15}, Months: 1, Out: Date{2020, 2, 15}},
+		TC{In: Date{2020, 3, 31}, Months: -1, Out: Date{2020, 2, 29}},
+		TC{In: Date{2020, 1, 31}, Months: -2, Out: Date{2019, 11, 30}},
+		TC{In: Date{2020, 12, 31}, Months: 14, Out: Date{2022, 2, 28}},
+		TC{In: Date{2020, 5, 31}, Months: 0, Out: Date{2020, 5, 31}},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.AddMonthsClamped(tc.Months), "%v + %d months", tc.In, tc.Months)
+	}
+}
+
+func TestDate_AddYearsClamped(t *testing.T) {
+	dLeap := Date{Year: 2020, Month: 2, Day: 29}
+	assert.Equal(t, Date{Year: 2021, Month: 2, Day: 28}, dLeap.AddYearsClamped(1))
+	assert.Equal(t, Date{Year: 2024, Month: 2, Day: 29}, dLeap.AddYearsClamped(4))
+	assert.Equal(t, Date{Year: 2019, Month: 2, Day: 28}, dLeap.AddYearsClamped(-1))
+}
+
 func TestDate_Value(t *testing.T) {
 	d := Date{
 		Year:  2020,
@@ -180,7 +517,7 @@ func TestDate_Scan_String(t *testing.T) {
 	d := &Date{}
 	var v interface{}
 	v = "2020-02-29"
-	d.Scan(v)
+	assert.NoError(t, d.Scan(v))
 	assert.Equal(t, Date{Year: 2020, Month: 2, Day: 29}, *d)
 }
 
@@ -188,10 +525,158 @@ func TestDate_Scan_Time(t *testing.T) {
 	d := &Date{}
 	var v interface{}
 	v = time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)
-	d.Scan(v)
+	assert.NoError(t, d.Scan(v))
 	assert.Equal(t, Date{Year: 2020, Month: 2, Day: 29}, *d)
 }
 
+func TestDate_Scan_Bytes(t *testing.T) {
+	d := &Date{}
+	assert.NoError(t, d.Scan([]byte("2020-02-29")))
+	assert.Equal(t, Date{Year: 2020, Month: 2, Day: 29}, *d)
+
+	assert.Error(t, d.Scan([]byte("2020-02-30")))
+	assert.Equal(t, Date{Year: 2020, Month: 2, Day: 29}, *d)
+}
+
+func TestNewTime(t *testing.T) {
+	tm, err := NewTime(23, 59, 59, 999999999)
+	assert.NoError(t, err)
+	assert.Equal(t, Time{23, 59, 59, 999999999}, tm)
+
+	for _, in := range [][4]int{{24, 0, 0, 0}, {-1, 0, 0, 0}, {0, 60, 0, 0}, {0, 0, 60, 0}, {0, 0, 0, 1000000000}, {0, 0, 0, -1}} {
+		tm, err := NewTime(in[0], in[1], in[2], in[3])
+		assert.True(t, errors.Is(err, ErrInvalidTime), "%v: %v", in, err)
+		assert.Equal(t, Time{}, tm)
+	}
+
+	_, err = NewTime(0, 60, 0, 0)
+	assert.EqualError(t, err, "civil: invalid time of day: minute 60 is not in [0,59]")
+}
+
+func TestTime_Normalize(t *testing.T) {
+	type TC struct {
+		In      Time
+		Out     Time
+		Changed bool
+	}
+	tcs := []TC{
+		TC{In: Time{3, 42, 31, 0}, Out: Time{3, 42, 31, 0}},
+		TC{In: Time{3, 42, 75, 0}, Out: Time{3, 43, 15, 0}, Changed: true},
+		TC{In: Time{3, 59, 59, 1500000000}, Out: Time{4, 0, 0, 500000000}, Changed: true},
+		TC{In: Time{25, 0, 0, 0}, Out: Time{1, 0, 0, 0}, Changed: true},
+		TC{In: Time{24, 0, 0, 0}, Out: Time{}, Changed: true},
+		TC{In: Time{0, -1, 0, 0}, Out: Time{23, 59, 0, 0}, Changed: true},
+	}
+	for _, tc := range tcs {
+		tm, changed := tc.In.Normalize()
+		assert.Equal(t, tc.Out, tm, "%v", tc.In)
+		assert.Equal(t, tc.Changed, changed, "%v", tc.In)
+	}
+}
+
+func TestMustParseTime(t *testing.T) {
+	assert.Equal(t, Time{3, 42, 31, 500000000}, MustParseTime("03:42:31.5"))
+	assert.Panics(t, func() { MustParseTime("3pm") })
+}
+
+func TestTime_Add(t *testing.T) {
+	type TC struct {
+		In   Time
+		D    time.Duration
+		Out  Time
+		Days int
+	}
+	tcs := []TC{
+		TC{In: Time{9, 30, 0, 0}, D: 90 * time.Minute, Out: Time{11, 0, 0, 0}, Days: 0},
+		TC{In: Time{23, 0, 0, 0}, D: 90 * time.Minute, Out: Time{0, 30, 0, 0}, Days: 1},
+		TC{In: Time{0, 15, 0, 0}, D: -30 * time.Minute, Out: Time{23, 45, 0, 0}, Days: -1},
+		TC{In: Time{12, 0, 0, 0}, D: 72 * time.Hour, Out: Time{12, 0, 0, 0}, Days: 3},
+		TC{In: Time{12, 0, 0, 0}, D: -50 * time.Hour, Out: Time{10, 0, 0, 0}, Days: -2},
+		TC{In: Time{23, 59, 59, 999999999}, D: time.Nanosecond, Out: Time{}, Days: 1},
+		TC{In: Time{24, 0, 0, 0}, D: 0, Out: Time{}, Days: 1},
+		TC{In: Time{}, D: time.Duration(math.MaxInt64), Out: Time{23, 47, 16, 854775807}, Days: 106751},
+		TC{In: Time{23, 0, 0, 0}, D: time.Duration(math.MinInt64), Out: Time{23, 12, 43, 145224192}, Days: -106752},
+	}
+	for _, tc := range tcs {
+		out, days := tc.In.Add(tc.D)
+		assert.Equal(t, tc.Out, out, "%v + %v", tc.In, tc.D)
+		assert.Equal(t, tc.Days, days, "%v + %v", tc.In, tc.D)
+	}
+}
+
+func TestTime_Sub(t *testing.T) {
+	type TC struct {
+		A, B Time
+		Out  time.Duration
+	}
+	tcs := []TC{
+		TC{A: Time{17, 30, 0, 0}, B: Time{9, 0, 0, 0}, Out: 8*time.Hour + 30*time.Minute},
+		TC{A: Time{9, 0, 0, 0}, B: Time{17, 30, 0, 0}, Out: -8*time.Hour - 30*time.Minute},
+		TC{A: Time{0, 0, 1, 5}, B: Time{0, 0, 0, 999999999}, Out: 6 * time.Nanosecond},
+		TC{A: Time{24, 0, 0, 0}, B: Time{}, Out: 24 * time.Hour},
+		TC{A: Time{12, 0, 0, 0}, B: Time{12, 0, 0, 0}, Out: 0},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.A.Sub(tc.B), "%v - %v", tc.A, tc.B)
+	}
+
+	assert.Equal(t, 3*time.Hour+42*time.Minute+31*time.Second+5, Time{3, 42, 31, 5}.DurationSinceMidnight())
+	assert.Equal(t, time.Duration(0), Time{}.DurationSinceMidnight())
+	assert.Equal(t, 24*time.Hour, Time{24, 0, 0, 0}.DurationSinceMidnight())
+}
+
+func TestTime_TruncateRound(t *testing.T) {
+	type TC struct {
+		In       Time
+		D        time.Duration
+		Truncate Time
+		Round    Time
+	}
+	tcs := []TC{
+		TC{In: Time{3, 42, 31, 567890123}, D: time.Second, Truncate: Time{3, 42, 31, 0}, Round: Time{3, 42, 32, 0}},
+		TC{In: Time{3, 42, 31, 567890123}, D: time.Millisecond, Truncate: Time{3, 42, 31, 567000000}, Round: Time{3, 42, 31, 568000000}},
+		TC{In: Time{3, 42, 31, 567890123}, D: time.Minute, Truncate: Time{3, 42, 0, 0}, Round: Time{3, 43, 0, 0}},
+		TC{In: Time{3, 42, 31, 567890123}, D: time.Hour, Truncate: Time{3, 0, 0, 0}, Round: Time{4, 0, 0, 0}},
+		TC{In: Time{3, 29, 59, 0}, D: time.Hour, Truncate: Time{3, 0, 0, 0}, Round: Time{3, 0, 0, 0}},
+		TC{In: Time{3, 30, 0, 0}, D: time.Hour, Truncate: Time{3, 0, 0, 0}, Round: Time{4, 0, 0, 0}},
+		TC{In: Time{3, 42, 0, 0}, D: 15 * time.Minute, Truncate: Time{3, 30, 0, 0}, Round: Time{3, 45, 0, 0}},
+		TC{In: Time{23, 59, 59, 600000000}, D: time.Second, Truncate: Time{23, 59, 59, 0}, Round: Time{24, 0, 0, 0}},
+		TC{In: Time{23, 59, 60, 500000000}, D: time.Second, Truncate: Time{24, 0, 0, 0}, Round: Time{24, 0, 0, 0}},
+		TC{In: Time{3, 42, 31, 5}, D: 0, Truncate: Time{3, 42, 31, 5}, Round: Time{3, 42, 31, 5}},
+		TC{In: Time{3, 42, 31, 5}, D: -time.Second, Truncate: Time{3, 42, 31, 5}, Round: Time{3, 42, 31, 5}},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Truncate, tc.In.Truncate(tc.D), "%v truncated to %v", tc.In, tc.D)
+		assert.Equal(t, tc.Round, tc.In.Round(tc.D), "%v rounded to %v", tc.In, tc.D)
+	}
+}
+
+func TestTime_NanosOfDay(t *testing.T) {
+	type TC struct {
+		In  Time
+		Out int64
+	}
+	tcs := []TC{
+		TC{In: Time{}, Out: 0},
+		TC{In: Time{0, 0, 0, 1}, Out: 1},
+		TC{In: Time{3, 42, 31, 5}, Out: 13351000000005},
+		TC{In: Time{23, 59, 59, 999999999}, Out: 86399999999999},
+		TC{In: Time{24, 0, 0, 0}, Out: 86400000000000},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.NanosOfDay(), "%v", tc.In)
+
+		tm, err := TimeFromNanosOfDay(tc.Out)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.In, tm, "%d", tc.Out)
+	}
+
+	for _, n := range []int64{-1, 86400000000001} {
+		_, err := TimeFromNanosOfDay(n)
+		assert.True(t, errors.Is(err, ErrInvalidTime), "%d", n)
+	}
+}
+
 func TestTime_MarshalJSON(t *testing.T) {
 	time := Time{
 		Hour:       3,
@@ -228,7 +713,168 @@ func TestTime_UnmarshalJSON(t *testing.T) {
 	jsonInvalid := []byte(`"-3:42:31.000000876"`)
 	timeInvalid := &Time{}
 	err = timeInvalid.UnmarshalJSON(jsonInvalid)
-	assert.EqualError(t, err, "invalid time: parsing time \"-3:42:31.000000876\" as \"15:04:05.999999999\": cannot parse \"-3:42:31.000000876\" as \"15\"")
+	assert.EqualError(t, err, `invalid time: civil: parsing "-3:42:31.000000876": expected 1 or 2 digits for hour at offset 0`)
+}
+
+func TestTime_Format(t *testing.T) {
+	tm := Time{Hour: 15, Minute: 4, Second: 5, Nanosecond: 876000000}
+
+	assert.Equal(t, "15:04", tm.Format("15:04"))
+	assert.Equal(t, "3:04 PM", tm.Format("3:04 PM"))
+	assert.Equal(t, "15:04:05.876", tm.Format("15:04:05.000"))
+	assert.Equal(t, "12:00 AM", Time{}.Format("03:04 PM"))
+}
+
+func TestParseTimeLayout(t *testing.T) {
+	tm, err := ParseTimeLayout("15:04", "03:42")
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 3, Minute: 42}, tm)
+
+	tm, err = ParseTimeLayout("3:04 PM", "3:42 PM")
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 15, Minute: 42}, tm)
+
+	_, err = ParseTimeLayout("15:04", "25:00")
+	assert.Error(t, err)
+}
+
+func TestTime_ISO8601Basic(t *testing.T) {
+	tm, err := ParseTimeLayout(ISO8601BasicTime, "034231")
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 3, Minute: 42, Second: 31}, tm)
+	assert.Equal(t, "034231", tm.Format(ISO8601BasicTime))
+
+	tm, err = ParseTimeLayout(ISO8601BasicTime, "034231.000000876")
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876}, tm)
+	assert.Equal(t, "034231.000000876", tm.Format(ISO8601BasicTime))
+
+	_, err = ParseTimeLayout(ISO8601BasicTime, "03:42:31")
+	assert.Error(t, err)
+}
+
+func TestTime_StringPrecision(t *testing.T) {
+	tm := Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876543219}
+
+	assert.Equal(t, "03:42:31.876543219", tm.StringPrecision(PrecisionAuto))
+	assert.Equal(t, "03:42:31", tm.StringPrecision(PrecisionSeconds))
+	assert.Equal(t, "03:42:31.876", tm.StringPrecision(PrecisionMillis))
+	assert.Equal(t, "03:42:31.876543", tm.StringPrecision(PrecisionMicros))
+	assert.Equal(t, "03:42:31.876543219", tm.StringPrecision(PrecisionNanos))
+	assert.Equal(t, "03:42:31.8765432", tm.StringPrecision(7))
+	assert.Equal(t, "03:42:31.876543219", tm.StringPrecision(12))
+
+	zero := Time{Hour: 3, Minute: 42, Second: 31}
+	assert.Equal(t, "03:42:31", zero.StringPrecision(PrecisionAuto))
+	assert.Equal(t, "03:42:31.000", zero.StringPrecision(PrecisionMillis))
+}
+
+func TestTime_Format12(t *testing.T) {
+	assert.Equal(t, "12:00 AM", Time{}.Format12())
+	assert.Equal(t, "12:00 PM", Time{Hour: 12}.Format12())
+	assert.Equal(t, "03:42 PM", Time{Hour: 15, Minute: 42, Second: 31}.Format12())
+	assert.Equal(t, "11:59 PM", Time{Hour: 23, Minute: 59}.Format12())
+	assert.Equal(t, "01:05 AM", Time{Hour: 1, Minute: 5}.Format12())
+}
+
+func TestParseTime12(t *testing.T) {
+	type TC struct {
+		In     string
+		Out    Time
+		HasErr bool
+	}
+	tcs := []TC{
+		TC{In: "12:00 AM", Out: Time{}},
+		TC{In: "12:00 PM", Out: Time{Hour: 12}},
+		TC{In: "12:30 am", Out: Time{Minute: 30}},
+		TC{In: "3:42 PM", Out: Time{Hour: 15, Minute: 42}},
+		TC{In: "03:42PM", Out: Time{Hour: 15, Minute: 42}},
+		TC{In: "11:59:59.5 pm", Out: Time{23, 59, 59, 500000000}},
+		TC{In: "1:05 Am", Out: Time{Hour: 1, Minute: 5}},
+		/* === ERRORS === */
+		TC{In: "0:30 AM", HasErr: true},
+		TC{In: "13:00 PM", HasErr: true},
+		TC{In: "3:42", HasErr: true},
+		TC{In: "3 PM", HasErr: true},
+		TC{In: "3:60 PM", HasErr: true},
+		TC{In: "PM", HasErr: true},
+		TC{In: "3:42:31.1234567890 PM", HasErr: true},
+	}
+	for _, tc := range tcs {
+		tm, err := ParseTime12(tc.In)
+		if tc.HasErr {
+			assert.Error(t, err, tc.In)
+			continue
+		}
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, tm, tc.In)
+	}
+}
+
+func TestParseTimeAny(t *testing.T) {
+	tm, layout, err := ParseTimeAny("3:42 PM", "15:04:05", "15:04", "3:04 PM")
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 15, Minute: 42}, tm)
+	assert.Equal(t, "3:04 PM", layout)
+
+	_, _, err = ParseTimeAny("noon", "15:04:05", "15:04")
+	assert.Error(t, err)
+}
+
+func TestTime_Append(t *testing.T) {
+	tm := Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876}
+	prefix := []byte("at ")
+
+	assert.Equal(t, []byte("at 3:42 AM"), tm.AppendFormat(prefix, "3:04 PM"))
+
+	b, err := tm.AppendText(prefix)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("at 03:42:31.000000876"), b)
+
+	b, err = tm.AppendJSON(prefix)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`at "03:42:31.000000876"`), b)
+}
+
+func TestTime_WithoutSeconds(t *testing.T) {
+	tm, err := ParseTime("03:42")
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 3, Minute: 42}, tm)
+
+	tm = Time{}
+	err = tm.UnmarshalJSON([]byte(`"23:59"`))
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 23, Minute: 59}, tm)
+
+	tm, err = ParseOptions{Lenient: true}.ParseTime("3:7")
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 3, Minute: 7}, tm)
+
+	for _, s := range []string{"03:4", "24:00", "03:60", "03:42:"} {
+		_, err = ParseTime(s)
+		assert.Error(t, err, s)
+	}
+
+	tm = Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876}
+	assert.Equal(t, "03:42", tm.StringPrecision(PrecisionMinutes))
+}
+
+func TestTime_CommaDecimalSeparator(t *testing.T) {
+	tm, err := ParseTime("03:42:31,000000876")
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876}, tm)
+
+	tm = Time{}
+	err = tm.UnmarshalJSON([]byte(`"03:42:31,5"`))
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 500000000}, tm)
+
+	tm, err = ParseOptions{Lenient: true}.ParseTime("3:42:31,25")
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 250000000}, tm)
+
+	_, err = ParseTime("03:42:31,1234567890")
+	assert.Error(t, err)
 }
 
 func TestTime_Value(t *testing.T) {
@@ -248,7 +894,7 @@ func TestTime_Scan_String(t *testing.T) {
 	time := &Time{}
 	var v interface{}
 	v = "03:42:31.000000876"
-	time.Scan(v)
+	assert.NoError(t, time.Scan(v))
 	assert.Equal(t, *time, Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876})
 }
 
@@ -256,10 +902,131 @@ func TestTime_Scan_Time(t *testing.T) {
 	tm := &Time{}
 	var v interface{}
 	v = time.Date(2020, time.February, 29, 3, 42, 31, 876, time.UTC)
-	tm.Scan(v)
+	assert.NoError(t, tm.Scan(v))
 	assert.Equal(t, *tm, Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876})
 }
 
+func TestTime_Scan_Bytes(t *testing.T) {
+	tm := &Time{}
+	assert.NoError(t, tm.Scan([]byte("03:42:31.000000876")))
+	assert.Equal(t, Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876}, *tm)
+}
+
+func TestNewDateTime(t *testing.T) {
+	dt, err := NewDateTime(2020, 2, 29, 3, 42, 31, 876)
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876}}, dt)
+
+	_, err = NewDateTime(2021, 2, 29, 3, 42, 31, 0)
+	assert.True(t, errors.Is(err, ErrInvalidDay))
+	_, err = NewDateTime(2020, 2, 29, 3, 42, 61, 0)
+	assert.True(t, errors.Is(err, ErrInvalidTime))
+}
+
+func TestDateTime_Normalize(t *testing.T) {
+	type TC struct {
+		In      DateTime
+		Out     DateTime
+		Changed bool
+	}
+	tcs := []TC{
+		TC{In: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}, Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}},
+		TC{In: DateTime{Date{2020, 2, 29}, Time{24, 0, 0, 0}}, Out: DateTime{Date{2020, 3, 1}, Time{}}, Changed: true},
+		TC{In: DateTime{Date{2020, 12, 31}, Time{23, 59, 60, 0}}, Out: DateTime{Date{2021, 1, 1}, Time{}}, Changed: true},
+		TC{In: DateTime{Date{2020, 13, 1}, Time{-1, 0, 0, 0}}, Out: DateTime{Date{2020, 12, 31}, Time{23, 0, 0, 0}}, Changed: true},
+	}
+	for _, tc := range tcs {
+		dt, changed := tc.In.Normalize()
+		assert.Equal(t, tc.Out, dt, "%v", tc.In)
+		assert.Equal(t, tc.Changed, changed, "%v", tc.In)
+	}
+}
+
+func TestDateTime_Add(t *testing.T) {
+	type TC struct {
+		In  DateTime
+		D   time.Duration
+		Out DateTime
+	}
+	tcs := []TC{
+		TC{In: DateTime{Date{2020, 2, 29}, Time{9, 30, 0, 0}}, D: 90 * time.Minute, Out: DateTime{Date{2020, 2, 29}, Time{11, 0, 0, 0}}},
+		TC{In: DateTime{Date{2020, 2, 29}, Time{23, 0, 0, 0}}, D: 90 * time.Minute, Out: DateTime{Date{2020, 3, 1}, Time{0, 30, 0, 0}}},
+		TC{In: DateTime{Date{2020, 12, 31}, Time{23, 59, 59, 999999999}}, D: time.Nanosecond, Out: DateTime{Date{2021, 1, 1}, Time{}}},
+		TC{In: DateTime{Date{2020, 3, 1}, Time{0, 15, 0, 0}}, D: -30 * time.Minute, Out: DateTime{Date{2020, 2, 29}, Time{23, 45, 0, 0}}},
+		TC{In: DateTime{Date{2020, 3, 8}, Time{1, 0, 0, 0}}, D: 48 * time.Hour, Out: DateTime{Date{2020, 3, 10}, Time{1, 0, 0, 0}}},
+		TC{In: DateTime{Date{2020, 2, 29}, Time{24, 0, 0, 0}}, D: time.Hour, Out: DateTime{Date{2020, 3, 1}, Time{1, 0, 0, 0}}},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.Add(tc.D), "%v + %v", tc.In, tc.D)
+	}
+}
+
+func TestDateTime_Sub(t *testing.T) {
+	type TC struct {
+		A, B DateTime
+		Out  time.Duration
+	}
+	tcs := []TC{
+		TC{A: DateTime{Date{2020, 2, 29}, Time{11, 0, 0, 0}}, B: DateTime{Date{2020, 2, 29}, Time{9, 30, 0, 0}}, Out: 90 * time.Minute},
+		TC{A: DateTime{Date{2020, 3, 1}, Time{0, 30, 0, 0}}, B: DateTime{Date{2020, 2, 28}, Time{23, 0, 0, 0}}, Out: 25*time.Hour + 30*time.Minute},
+		TC{A: DateTime{Date{2020, 2, 29}, Time{9, 30, 0, 0}}, B: DateTime{Date{2020, 2, 29}, Time{9, 30, 0, 1}}, Out: -time.Nanosecond},
+		TC{A: DateTime{Date{2020, 2, 29}, Time{24, 0, 0, 0}}, B: DateTime{Date{2020, 3, 1}, Time{}}, Out: 0},
+		TC{A: DateTime{Date{9999, 12, 31}, Time{}}, B: DateTime{Date{0, 1, 1}, Time{}}, Out: time.Duration(math.MaxInt64)},
+		TC{A: DateTime{Date{0, 1, 1}, Time{}}, B: DateTime{Date{9999, 12, 31}, Time{}}, Out: time.Duration(math.MinInt64)},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.A.Sub(tc.B), "%v - %v", tc.A, tc.B)
+		if tc.Out != math.MaxInt64 && tc.Out != math.MinInt64 {
+			assert.True(t, tc.B.Add(tc.Out).In(time.UTC).Equal(tc.A.In(time.UTC)), "%v - %v", tc.A, tc.B)
+		}
+	}
+}
+
+func TestDateTime_TruncateRound(t *testing.T) {
+	type TC struct {
+		In       string
+		D        time.Duration
+		Truncate string
+		Round    string
+	}
+	tcs := []TC{
+		TC{In: "2020-02-29T03:42:31.567890123", D: time.Millisecond, Truncate: "2020-02-29T03:42:31.567", Round: "2020-02-29T03:42:31.568"},
+		TC{In: "2020-02-29T03:42:31.567890123", D: time.Second, Truncate: "2020-02-29T03:42:31", Round: "2020-02-29T03:42:32"},
+		TC{In: "2020-02-29T03:42:31.567890123", D: time.Minute, Truncate: "2020-02-29T03:42:00", Round: "2020-02-29T03:43:00"},
+		TC{In: "2020-02-29T03:42:31.567890123", D: time.Hour, Truncate: "2020-02-29T03:00:00", Round: "2020-02-29T04:00:00"},
+		TC{In: "2020-02-29T03:42:31.567890123", D: 24 * time.Hour, Truncate: "2020-02-29T00:00:00", Round: "2020-02-29T00:00:00"},
+		TC{In: "2020-02-29T12:00:00", D: 24 * time.Hour, Truncate: "2020-02-29T00:00:00", Round: "2020-03-01T00:00:00"},
+		TC{In: "2020-02-29T12:00:00", D: 7 * 24 * time.Hour, Truncate: "2020-02-29T00:00:00", Round: "2020-03-01T00:00:00"},
+		TC{In: "2020-12-31T23:59:59.6", D: time.Second, Truncate: "2020-12-31T23:59:59", Round: "2021-01-01T00:00:00"},
+		TC{In: "2020-12-31T23:30:00", D: time.Hour, Truncate: "2020-12-31T23:00:00", Round: "2021-01-01T00:00:00"},
+	}
+	for _, tc := range tcs {
+		in := MustParseDateTime(tc.In)
+		assert.Equal(t, MustParseDateTime(tc.Truncate), in.Truncate(tc.D), "%v truncated to %v", tc.In, tc.D)
+		assert.Equal(t, MustParseDateTime(tc.Round), in.Round(tc.D), "%v rounded to %v", tc.In, tc.D)
+	}
+
+	dt := MustParseDateTime("2020-02-29T03:42:31")
+	assert.Equal(t, dt, dt.Truncate(0))
+	assert.Equal(t, dt, dt.Round(-time.Second))
+
+	eod := DateTime{Date{2020, 2, 29}, Time{24, 0, 0, 0}}
+	assert.Equal(t, DateTime{Date{2020, 3, 1}, Time{}}, eod.Truncate(time.Hour))
+	assert.Equal(t, DateTime{Date{2020, 3, 1}, Time{}}, eod.Round(time.Hour))
+}
+
+func TestDateTime_RoundToDate(t *testing.T) {
+	assert.Equal(t, Date{2020, 2, 29}, MustParseDateTime("2020-02-29T11:59:59.999999999").RoundToDate())
+	assert.Equal(t, Date{2020, 3, 1}, MustParseDateTime("2020-02-29T12:00:00").RoundToDate())
+	assert.Equal(t, Date{2021, 1, 1}, MustParseDateTime("2020-12-31T23:00:00").RoundToDate())
+	assert.Equal(t, Date{2020, 2, 29}, MustParseDateTime("2020-02-29T00:00:00").RoundToDate())
+}
+
+func TestMustParseDateTime(t *testing.T) {
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{3, 42, 0, 0}}, MustParseDateTime("2020-02-29T03:42"))
+	assert.Panics(t, func() { MustParseDateTime("2020-02-29") })
+}
+
 func TestDateTime_MarshalJSON(t *testing.T) {
 
 	datetime := DateTime{
@@ -315,8 +1082,8 @@ func TestDateTime_RoundTrip_JSON(t *testing.T) {
 			Out:          []byte(`"2020-13-04T12:23:34.000000005"`),
 			UnmarshalErr: true},
 		TC{Name: "DT-bad-year", In: DateTime{Date{-2020, 3, 4}, Time{12, 23, 34, 5}},
-			Out:          []byte(`"-2020-03-04T12:23:34.000000005"`),
-			UnmarshalErr: true},
+			Out:        []byte(`"-2020-03-04T12:23:34.000000005"`),
+			MarshalErr: true},
 		TC{Name: "DT-bad-hour", In: DateTime{Date{2020, 3, 4}, Time{24, 0, 0, 0}},
 			Out:          []byte(`"2020-03-04T24:00:00"`),
 			UnmarshalErr: true},
@@ -395,6 +1162,126 @@ func TestDateTime_UnmarshalJSON(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestDateTime_Format(t *testing.T) {
+	dt := DateTime{Date: Date{2020, 2, 29}, Time: Time{3, 42, 31, 876}}
+
+	assert.Equal(t, "2020-02-29 03:42:31", dt.Format("2006-01-02 15:04:05"))
+	assert.Equal(t, "29/02/2020 03:42", dt.Format("02/01/2006 15:04"))
+	assert.Equal(t, dt.String(), dt.Format(RFC3339DateTime))
+}
+
+func TestParseDateTimeLayout(t *testing.T) {
+	dt, err := ParseDateTimeLayout("2006-01-02 15:04:05", "2020-02-29 03:42:31")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date: Date{2020, 2, 29}, Time: Time{3, 42, 31, 0}}, dt)
+
+	dt, err = ParseDateTimeLayout("2006-01-02 15:04:05 -0700", "2020-02-29 03:42:31 +0200")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date: Date{2020, 2, 29}, Time: Time{3, 42, 31, 0}}, dt)
+
+	_, err = ParseDateTimeLayout("2006-01-02 15:04:05", "2020-02-29T03:42:31")
+	assert.Error(t, err)
+}
+
+func TestDateTime_ISO8601Basic(t *testing.T) {
+	dt, err := ParseDateTimeLayout(ISO8601BasicDateTime, "20200229T034231")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date: Date{2020, 2, 29}, Time: Time{3, 42, 31, 0}}, dt)
+	assert.Equal(t, "20200229T034231", dt.Format(ISO8601BasicDateTime))
+
+	dt.Time.Nanosecond = 500000000
+	assert.Equal(t, "20200229T034231.5", dt.Format(ISO8601BasicDateTime))
+
+	_, err = ParseDateTimeLayout(ISO8601BasicDateTime, "20200229034231")
+	assert.Error(t, err)
+}
+
+func TestParseDateTimeAny(t *testing.T) {
+	dt, layout, err := ParseDateTimeAny("2020-02-29 03:42:31", RFC3339DateTime, "2006-01-02 15:04:05")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date: Date{2020, 2, 29}, Time: Time{3, 42, 31, 0}}, dt)
+	assert.Equal(t, "2006-01-02 15:04:05", layout)
+
+	_, _, err = ParseDateTimeAny("2020-02-29", RFC3339DateTime, "2006-01-02 15:04:05")
+	assert.Error(t, err)
+}
+
+func TestDateTime_Append(t *testing.T) {
+	dt := DateTime{Date: Date{2020, 2, 29}, Time: Time{3, 42, 31, 0}}
+	prefix := []byte("ts=")
+
+	assert.Equal(t, []byte("ts=2020-02-29 03:42"), dt.AppendFormat(prefix, "2006-01-02 15:04"))
+
+	b, err := dt.AppendText(prefix)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("ts=2020-02-29T03:42:31"), b)
+
+	b, err = dt.AppendJSON(prefix)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`ts="2020-02-29T03:42:31"`), b)
+}
+
+func TestDateTime_WithoutSeconds(t *testing.T) {
+	want := DateTime{Date: Date{2020, 2, 29}, Time: Time{Hour: 3, Minute: 42}}
+
+	dt, err := ParseDateTime("2020-02-29T03:42")
+	assert.NoError(t, err)
+	assert.Equal(t, want, dt)
+
+	dt, err = ParseDateTime("2020-02-29t03:42")
+	assert.NoError(t, err)
+	assert.Equal(t, want, dt)
+
+	dt = DateTime{}
+	err = dt.UnmarshalJSON([]byte(`"2020-02-29T03:42"`))
+	assert.NoError(t, err)
+	assert.Equal(t, want, dt)
+
+	dt, err = ParseOptions{Lenient: true}.ParseDateTime("2020-2-29T3:42")
+	assert.NoError(t, err)
+	assert.Equal(t, want, dt)
+
+	_, err = ParseDateTime("2020-02-29T03")
+	assert.Error(t, err)
+
+	dt.Time.Second = 31
+	assert.Equal(t, "2020-02-29T03:42", dt.StringPrecision(PrecisionMinutes))
+}
+
+func TestDateTime_CommaDecimalSeparator(t *testing.T) {
+	want := DateTime{Date: Date{2020, 2, 29}, Time: Time{3, 42, 31, 876}}
+
+	dt, err := ParseDateTime("2020-02-29T03:42:31,000000876")
+	assert.NoError(t, err)
+	assert.Equal(t, want, dt)
+
+	dt = DateTime{}
+	err = dt.UnmarshalJSON([]byte(`"2020-02-29T03:42:31,000000876"`))
+	assert.NoError(t, err)
+	assert.Equal(t, want, dt)
+
+	dt = DateTime{}
+	err = dt.UnmarshalText([]byte("2020-02-29T03:42:31,000000876"))
+	assert.NoError(t, err)
+	assert.Equal(t, want, dt)
+
+	_, err = ParseDateTime("2020-02-29T03:42:31,1234567890")
+	assert.Error(t, err)
+}
+
+func TestDateTime_UnmarshalJSON_KeepsInput(t *testing.T) {
+	data := []byte(`"2020-02-29T03:42:31"`)
+	var dt DateTime
+	assert.NoError(t, dt.UnmarshalJSON(data))
+	assert.Equal(t, []byte(`"2020-02-29T03:42:31"`), data)
+}
+
+func TestParseDateTime_ZeroDate(t *testing.T) {
+	dt, err := ParseDateTime(DateTime{}.String())
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{}, dt)
+}
+
 func TestDateTime_Value(t *testing.T) {
 	datetime := DateTime{
 		Date: Date{
@@ -419,7 +1306,7 @@ func TestDateTime_ScanString(t *testing.T) {
 	datetime := &DateTime{}
 	var v interface{}
 	v = "2020-02-29T03:42:31.000000876"
-	datetime.Scan(v)
+	assert.NoError(t, datetime.Scan(v))
 	expected := DateTime{
 		Date: Date{
 			Year:  2020,
@@ -436,11 +1323,17 @@ func TestDateTime_ScanString(t *testing.T) {
 	assert.Equal(t, *datetime, expected)
 }
 
+func TestDateTime_Scan_Bytes(t *testing.T) {
+	datetime := &DateTime{}
+	assert.NoError(t, datetime.Scan([]byte("2020-02-29T03:42:31.000000876")))
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876}}, *datetime)
+}
+
 func TestDateTime_Scan(t *testing.T) {
 	datetime := &DateTime{}
 	var v interface{}
 	v = time.Date(2020, time.February, 29, 3, 42, 31, 876, time.UTC)
-	datetime.Scan(v)
+	assert.NoError(t, datetime.Scan(v))
 	expected := DateTime{
 		Date: Date{
 			Year:  2020,
@@ -0,0 +1,90 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cloudcivil converts between civil values and the types of
+// cloud.google.com/go/civil, from which this package was forked, and the
+// nullable wrappers of cloud.google.com/go/bigquery. Google Cloud client
+// libraries such as BigQuery accept only their own civil types, so values
+// pass through these functions on the way in and out:
+//
+//	row := map[string]bigquery.Value{
+//		"hired": cloudcivil.ToDate(employee.Hired),
+//	}
+package cloudcivil
+
+import (
+	"cloud.google.com/go/bigquery"
+	gcivil "cloud.google.com/go/civil"
+	"github.com/openlyinc/civil"
+)
+
+// ToDate returns d as a cloud.google.com/go/civil Date.
+func ToDate(d civil.Date) gcivil.Date {
+	return gcivil.Date(d)
+}
+
+// FromDate returns the civil Date of d.
+func FromDate(d gcivil.Date) civil.Date {
+	return civil.Date(d)
+}
+
+// ToTime returns t as a cloud.google.com/go/civil Time.
+func ToTime(t civil.Time) gcivil.Time {
+	return gcivil.Time(t)
+}
+
+// FromTime returns the civil Time of t.
+func FromTime(t gcivil.Time) civil.Time {
+	return civil.Time(t)
+}
+
+// ToDateTime returns dt as a cloud.google.com/go/civil DateTime.
+func ToDateTime(dt civil.DateTime) gcivil.DateTime {
+	return gcivil.DateTime{Date: ToDate(dt.Date), Time: ToTime(dt.Time)}
+}
+
+// FromDateTime returns the civil DateTime of dt.
+func FromDateTime(dt gcivil.DateTime) civil.DateTime {
+	return civil.DateTime{Date: FromDate(dt.Date), Time: FromTime(dt.Time)}
+}
+
+// ToNullDate returns n as a bigquery.NullDate.
+func ToNullDate(n civil.NullDate) bigquery.NullDate {
+	return bigquery.NullDate{Date: ToDate(n.Date), Valid: n.Valid}
+}
+
+// FromNullDate returns the civil NullDate of n.
+func FromNullDate(n bigquery.NullDate) civil.NullDate {
+	return civil.NullDate{Date: FromDate(n.Date), Valid: n.Valid}
+}
+
+// ToNullTime returns n as a bigquery.NullTime.
+func ToNullTime(n civil.NullTime) bigquery.NullTime {
+	return bigquery.NullTime{Time: ToTime(n.Time), Valid: n.Valid}
+}
+
+// FromNullTime returns the civil NullTime of n.
+func FromNullTime(n bigquery.NullTime) civil.NullTime {
+	return civil.NullTime{Time: FromTime(n.Time), Valid: n.Valid}
+}
+
+// ToNullDateTime returns n as a bigquery.NullDateTime.
+func ToNullDateTime(n civil.NullDateTime) bigquery.NullDateTime {
+	return bigquery.NullDateTime{DateTime: ToDateTime(n.DateTime), Valid: n.Valid}
+}
+
+// FromNullDateTime returns the civil NullDateTime of n.
+func FromNullDateTime(n bigquery.NullDateTime) civil.NullDateTime {
+	return civil.NullDateTime{DateTime: FromDateTime(n.DateTime), Valid: n.Valid}
+}
@@ -0,0 +1,60 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudcivil
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	gcivil "cloud.google.com/go/civil"
+	"github.com/openlyinc/civil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTime_RoundTrip(t *testing.T) {
+	dt := civil.DateTime{
+		Date: civil.Date{Year: 2020, Month: time.February, Day: 29},
+		Time: civil.Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876},
+	}
+	g := ToDateTime(dt)
+	assert.Equal(t, "2020-02-29T03:42:31.000000876", g.String())
+	assert.Equal(t, dt, FromDateTime(g))
+
+	assert.Equal(t, gcivil.Date{Year: 2020, Month: time.February, Day: 29}, ToDate(dt.Date))
+	assert.Equal(t, dt.Date, FromDate(g.Date))
+	assert.Equal(t, gcivil.Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876}, ToTime(dt.Time))
+	assert.Equal(t, dt.Time, FromTime(g.Time))
+}
+
+func TestNull_RoundTrip(t *testing.T) {
+	d := civil.Date{Year: 2020, Month: time.February, Day: 29}
+	tm := civil.Time{Hour: 3, Minute: 42, Second: 31}
+	for _, valid := range []bool{true, false} {
+		nd := civil.NullDate{Date: d, Valid: valid}
+		assert.Equal(t, bigquery.NullDate{Date: ToDate(d), Valid: valid}, ToNullDate(nd))
+		assert.Equal(t, nd, FromNullDate(ToNullDate(nd)))
+
+		nt := civil.NullTime{Time: tm, Valid: valid}
+		assert.Equal(t, bigquery.NullTime{Time: ToTime(tm), Valid: valid}, ToNullTime(nt))
+		assert.Equal(t, nt, FromNullTime(ToNullTime(nt)))
+
+		ndt := civil.NullDateTime{DateTime: civil.DateTime{Date: d, Time: tm}, Valid: valid}
+		assert.Equal(t, ndt, FromNullDateTime(ToNullDateTime(ndt)))
+	}
+
+	// A NULL from BigQuery stays NULL.
+	assert.Equal(t, civil.NullDate{}, FromNullDate(bigquery.NullDate{}))
+}
@@ -0,0 +1,76 @@
+// Code generated by civilgen; DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/openlyinc/civil"
+)
+
+// DateYYYYMMDDLayout is the layout of DateYYYYMMDD.
+const DateYYYYMMDDLayout = "20060102"
+
+// DateYYYYMMDD is a civil.Date whose text, JSON and SQL form is
+// "20060102".
+type DateYYYYMMDD civil.Date
+
+// String returns the value formatted with DateYYYYMMDDLayout.
+func (v DateYYYYMMDD) String() string {
+	return civil.Date(v).Format(DateYYYYMMDDLayout)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v DateYYYYMMDD) MarshalText() ([]byte, error) {
+	return civil.Date(v).AppendFormat(nil, DateYYYYMMDDLayout), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *DateYYYYMMDD) UnmarshalText(data []byte) error {
+	val, err := civil.ParseDateLayout(DateYYYYMMDDLayout, string(data))
+	if err != nil {
+		return err
+	}
+	*v = DateYYYYMMDD(val)
+	return nil
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v DateYYYYMMDD) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *DateYYYYMMDD) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("DateYYYYMMDD should be a string, got %s", data)
+	}
+	return v.UnmarshalText([]byte(s))
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (v DateYYYYMMDD) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (v *DateYYYYMMDD) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case string:
+		return v.UnmarshalText([]byte(src))
+	case []byte:
+		return v.UnmarshalText(src)
+	case time.Time:
+		*v = DateYYYYMMDD(civil.DateOf(src))
+		return nil
+	}
+	return fmt.Errorf("%w: cannot scan %T into DateYYYYMMDD", civil.ErrUnsupportedType, src)
+}
@@ -0,0 +1,58 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openlyinc/civil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateYYYYMMDD(t *testing.T) {
+	d := DateYYYYMMDD(civil.Date{Year: 2020, Month: 2, Day: 29})
+	b, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Equal(t, `"20200229"`, string(b))
+
+	var d0 DateYYYYMMDD
+	assert.NoError(t, json.Unmarshal(b, &d0))
+	assert.Equal(t, d, d0)
+	assert.NoError(t, json.Unmarshal([]byte("null"), &d0))
+	assert.Equal(t, d, d0)
+
+	v, err := d.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "20200229", v)
+
+	for _, src := range []interface{}{"20200229", []byte("20200229"), time.Date(2020, 2, 29, 3, 42, 0, 0, time.UTC)} {
+		d0 = DateYYYYMMDD{}
+		assert.NoError(t, d0.Scan(src), src)
+		assert.Equal(t, d, d0, src)
+	}
+}
+
+/* === ERRORS === */
+
+func TestDateYYYYMMDD_Errors(t *testing.T) {
+	var d DateYYYYMMDD
+	assert.Error(t, d.UnmarshalJSON([]byte(`"2020-02-29"`)))
+	assert.Error(t, d.UnmarshalJSON([]byte(`20200229`)))
+	assert.Error(t, d.Scan("20200230"))
+	assert.True(t, errors.Is(d.Scan(int64(20200229)), civil.ErrUnsupportedType))
+}
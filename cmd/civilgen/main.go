@@ -0,0 +1,187 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command civilgen generates a named wrapper around civil.Date, civil.Time
+// or civil.DateTime whose text, JSON and SQL forms use a fixed layout, in
+// the reference-time notation of time.Format.
+//
+// Usage:
+//
+//	civilgen -type DateYYYYMMDD -base Date -layout 20060102 [-package name] [-o file]
+//
+// It is typically run from a go:generate directive:
+//
+//	//go:generate civilgen -type DateYYYYMMDD -base Date -layout 20060102
+//
+// The generated type converts to and from its base type, implements
+// fmt.Stringer, encoding.TextMarshaler, encoding.TextUnmarshaler,
+// json.Marshaler, json.Unmarshaler, driver.Valuer and sql.Scanner, and
+// treats JSON null as a no-op. By default the package is $GOPACKAGE and the
+// output file is the lower-cased type name with a _civil.go suffix.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/template"
+)
+
+func main() {
+	var c config
+	flag.StringVar(&c.Type, "type", "", "name of the generated type")
+	flag.StringVar(&c.Base, "base", "Date", "base type: Date, Time or DateTime")
+	flag.StringVar(&c.Layout, "layout", "", "layout in the reference-time notation of time.Format")
+	flag.StringVar(&c.Package, "package", os.Getenv("GOPACKAGE"), "package of the generated file")
+	out := flag.String("o", "", "output file (default <type>_civil.go)")
+	flag.Parse()
+
+	src, err := generate(c)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "civilgen:", err)
+		os.Exit(2)
+	}
+	if *out == "" {
+		*out = strings.ToLower(c.Type) + "_civil.go"
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, "civilgen:", err)
+		os.Exit(1)
+	}
+}
+
+// config describes the type to generate.
+type config struct {
+	Type    string
+	Base    string
+	Layout  string
+	Package string
+}
+
+// bases maps each base type to the civil function that parses it with a
+// layout and the one that converts a time.Time to it.
+var bases = map[string]struct{ Parse, Of string }{
+	"Date":     {"ParseDateLayout", "DateOf"},
+	"Time":     {"ParseTimeLayout", "TimeOf"},
+	"DateTime": {"ParseDateTimeLayout", "DateTimeOf"},
+}
+
+// generate returns the formatted source of the wrapper type.
+func generate(c config) ([]byte, error) {
+	base, ok := bases[c.Base]
+	switch {
+	case !token.IsIdentifier(c.Type) || !token.IsExported(c.Type):
+		return nil, fmt.Errorf("-type %q is not an exported identifier", c.Type)
+	case !ok:
+		return nil, fmt.Errorf("-base %q is not one of Date, Time and DateTime", c.Base)
+	case c.Layout == "":
+		return nil, errors.New("-layout is required")
+	case !token.IsIdentifier(c.Package):
+		return nil, fmt.Errorf("-package %q is not an identifier; set it or run from go generate", c.Package)
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, struct {
+		config
+		Parse, Of string
+	}{c, base.Parse, base.Of})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+var tmpl = template.Must(template.New("").Parse(`// Code generated by civilgen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/openlyinc/civil"
+)
+
+// {{.Type}}Layout is the layout of {{.Type}}.
+const {{.Type}}Layout = {{printf "%q" .Layout}}
+
+// {{.Type}} is a civil.{{.Base}} whose text, JSON and SQL form is
+// {{printf "%q" .Layout}}.
+type {{.Type}} civil.{{.Base}}
+
+// String returns the value formatted with {{.Type}}Layout.
+func (v {{.Type}}) String() string {
+	return civil.{{.Base}}(v).Format({{.Type}}Layout)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v {{.Type}}) MarshalText() ([]byte, error) {
+	return civil.{{.Base}}(v).AppendFormat(nil, {{.Type}}Layout), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *{{.Type}}) UnmarshalText(data []byte) error {
+	val, err := civil.{{.Parse}}({{.Type}}Layout, string(data))
+	if err != nil {
+		return err
+	}
+	*v = {{.Type}}(val)
+	return nil
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v {{.Type}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *{{.Type}}) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("{{.Type}} should be a string, got %s", data)
+	}
+	return v.UnmarshalText([]byte(s))
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (v {{.Type}}) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (v *{{.Type}}) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case string:
+		return v.UnmarshalText([]byte(src))
+	case []byte:
+		return v.UnmarshalText(src)
+	case time.Time:
+		*v = {{.Type}}(civil.{{.Of}}(src))
+		return nil
+	}
+	return fmt.Errorf("%w: cannot scan %T into {{.Type}}", civil.ErrUnsupportedType, src)
+}
+`))
@@ -0,0 +1,58 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate_Example(t *testing.T) {
+	want, err := ioutil.ReadFile("internal/example/dateyyyymmdd_civil.go")
+	assert.NoError(t, err)
+
+	got, err := generate(config{Type: "DateYYYYMMDD", Base: "Date", Layout: "20060102", Package: "example"})
+	assert.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "run go generate in internal/example")
+}
+
+func TestGenerate_Bases(t *testing.T) {
+	src, err := generate(config{Type: "ClockTime", Base: "Time", Layout: "3:04PM", Package: "p"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(src), "type ClockTime civil.Time")
+	assert.Contains(t, string(src), "civil.ParseTimeLayout(ClockTimeLayout, string(data))")
+	assert.Contains(t, string(src), `const ClockTimeLayout = "3:04PM"`)
+
+	src, err = generate(config{Type: "Stamp", Base: "DateTime", Layout: "2006-01-02 15:04:05", Package: "p"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(src), "civil.DateTimeOf(src)")
+}
+
+/* === ERRORS === */
+
+func TestGenerate_Errors(t *testing.T) {
+	for _, c := range []config{
+		{Type: "", Base: "Date", Layout: "20060102", Package: "p"},
+		{Type: "dateLocal", Base: "Date", Layout: "20060102", Package: "p"},
+		{Type: "D", Base: "Timestamp", Layout: "20060102", Package: "p"},
+		{Type: "D", Base: "Date", Layout: "", Package: "p"},
+		{Type: "D", Base: "Date", Layout: "20060102", Package: ""},
+	} {
+		_, err := generate(c)
+		assert.Error(t, err, c)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cqlcivil lets gocql exchange civil values with Cassandra's date
+// and time types.
+//
+// Declare the columns of a CQL model with the types of this package, which
+// implement gocql.Marshaler and gocql.Unmarshaler:
+//
+//	var hired cqlcivil.Date
+//	err := session.Query(`SELECT hired FROM employees WHERE id = ?`, id).Scan(&hired)
+//
+// Both types also read and write text, varchar and ascii columns in the
+// formats of the civil String methods. A NULL gives the zero value, as it
+// does for gocql's own types.
+package cqlcivil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/openlyinc/civil"
+)
+
+// unixEpoch is the date from which Cassandra counts days.
+var unixEpoch = civil.Date{Year: 1970, Month: time.January, Day: 1}
+
+// dateBias is the Cassandra date of 1970-01-01. Dates are unsigned 32-bit
+// day numbers centered on the epoch.
+const dateBias = 1 << 31
+
+// Date is a civil.Date that maps to the Cassandra date type.
+type Date civil.Date
+
+// MarshalCQL implements the gocql.Marshaler interface.
+func (d Date) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	switch info.Type() {
+	case gocql.TypeDate:
+		days := int64(civil.Date(d).DaysSince(unixEpoch)) + dateBias
+		if days < 0 || days > 1<<32-1 {
+			return nil, fmt.Errorf("%w: %v is not a Cassandra date", civil.ErrYearOutOfRange, civil.Date(d))
+		}
+		return binary.BigEndian.AppendUint32(nil, uint32(days)), nil
+	case gocql.TypeText, gocql.TypeVarchar, gocql.TypeAscii:
+		return []byte(civil.Date(d).String()), nil
+	}
+	return nil, fmt.Errorf("%w: cannot marshal civil.Date into %v", civil.ErrUnsupportedType, info)
+}
+
+// UnmarshalCQL implements the gocql.Unmarshaler interface.
+func (d *Date) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if data == nil {
+		*d = Date{}
+		return nil
+	}
+	switch info.Type() {
+	case gocql.TypeDate:
+		if len(data) != 4 {
+			return fmt.Errorf("%w: Cassandra date of %d bytes", civil.ErrInvalidFormat, len(data))
+		}
+		days := int64(binary.BigEndian.Uint32(data)) - dateBias
+		*d = Date(unixEpoch.AddDays(int(days)))
+		return nil
+	case gocql.TypeText, gocql.TypeVarchar, gocql.TypeAscii:
+		var val civil.Date
+		if err := val.UnmarshalText(data); err != nil {
+			return err
+		}
+		*d = Date(val)
+		return nil
+	}
+	return fmt.Errorf("%w: cannot unmarshal %v into civil.Date", civil.ErrUnsupportedType, info)
+}
+
+// Time is a civil.Time that maps to the Cassandra time type, which counts
+// nanoseconds since midnight.
+type Time civil.Time
+
+// MarshalCQL implements the gocql.Marshaler interface. It returns an error
+// wrapping civil.ErrInvalidTime for an invalid time, including the
+// end-of-day time 24:00, which the Cassandra time type cannot hold.
+func (t Time) MarshalCQL(info gocql.TypeInfo) ([]byte, error) {
+	if !civil.Time(t).IsValid() {
+		return nil, fmt.Errorf("%w: cannot marshal %v into Cassandra", civil.ErrInvalidTime, civil.Time(t))
+	}
+	switch info.Type() {
+	case gocql.TypeTime:
+		ns := (int64(t.Hour)*3600+int64(t.Minute)*60+int64(t.Second))*1e9 + int64(t.Nanosecond)
+		return binary.BigEndian.AppendUint64(nil, uint64(ns)), nil
+	case gocql.TypeText, gocql.TypeVarchar, gocql.TypeAscii:
+		return []byte(civil.Time(t).String()), nil
+	}
+	return nil, fmt.Errorf("%w: cannot marshal civil.Time into %v", civil.ErrUnsupportedType, info)
+}
+
+// UnmarshalCQL implements the gocql.Unmarshaler interface.
+func (t *Time) UnmarshalCQL(info gocql.TypeInfo, data []byte) error {
+	if data == nil {
+		*t = Time{}
+		return nil
+	}
+	switch info.Type() {
+	case gocql.TypeTime:
+		if len(data) != 8 {
+			return fmt.Errorf("%w: Cassandra time of %d bytes", civil.ErrInvalidFormat, len(data))
+		}
+		ns := int64(binary.BigEndian.Uint64(data))
+		if ns < 0 || ns >= int64(24*time.Hour) {
+			return fmt.Errorf("%w: %d nanoseconds is not in a day", civil.ErrInvalidTime, ns)
+		}
+		*t = Time{
+			Hour:       int(ns / int64(time.Hour)),
+			Minute:     int(ns / int64(time.Minute) % 60),
+			Second:     int(ns / int64(time.Second) % 60),
+			Nanosecond: int(ns % int64(time.Second)),
+		}
+		return nil
+	case gocql.TypeText, gocql.TypeVarchar, gocql.TypeAscii:
+		var val civil.Time
+		if err := val.UnmarshalText(data); err != nil {
+			return err
+		}
+		*t = Time(val)
+		return nil
+	}
+	return fmt.Errorf("%w: cannot unmarshal %v into civil.Time", civil.ErrUnsupportedType, info)
+}
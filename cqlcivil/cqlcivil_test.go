@@ -0,0 +1,121 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cqlcivil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/openlyinc/civil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ gocql.Marshaler   = Date{}
+	_ gocql.Unmarshaler = (*Date)(nil)
+	_ gocql.Marshaler   = Time{}
+	_ gocql.Unmarshaler = (*Time)(nil)
+)
+
+var (
+	dateType = gocql.NewNativeType(4, gocql.TypeDate, "")
+	timeType = gocql.NewNativeType(4, gocql.TypeTime, "")
+	textType = gocql.NewNativeType(4, gocql.TypeVarchar, "")
+	intType  = gocql.NewNativeType(4, gocql.TypeInt, "")
+)
+
+func TestDate(t *testing.T) {
+	type TC struct {
+		In  Date
+		Out []byte
+	}
+	tcs := []TC{
+		TC{In: Date{Year: 1970, Month: 1, Day: 1}, Out: []byte{0x80, 0, 0, 0}},
+		TC{In: Date{Year: 2020, Month: 2, Day: 29}, Out: []byte{0x80, 0, 0x47, 0x91}},
+		TC{In: Date{Year: 1969, Month: 12, Day: 31}, Out: []byte{0x7f, 0xff, 0xff, 0xff}},
+	}
+	for _, tc := range tcs {
+		b, err := tc.In.MarshalCQL(dateType)
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, b, tc.In)
+
+		var d Date
+		assert.NoError(t, d.UnmarshalCQL(dateType, b), tc.In)
+		assert.Equal(t, tc.In, d)
+	}
+
+	b, err := Date{Year: 2020, Month: 2, Day: 29}.MarshalCQL(textType)
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-02-29", string(b))
+	var d Date
+	assert.NoError(t, d.UnmarshalCQL(textType, b))
+	assert.Equal(t, Date{Year: 2020, Month: 2, Day: 29}, d)
+
+	assert.NoError(t, d.UnmarshalCQL(dateType, nil))
+	assert.Equal(t, Date{}, d)
+}
+
+func TestTime(t *testing.T) {
+	tm := Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876}
+	b, err := tm.MarshalCQL(timeType)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 0x0c, 0x24, 0x85, 0x9c, 0xc9, 0x6c}, b)
+
+	var tm0 Time
+	assert.NoError(t, tm0.UnmarshalCQL(timeType, b))
+	assert.Equal(t, tm, tm0)
+
+	b, err = tm.MarshalCQL(textType)
+	assert.NoError(t, err)
+	assert.Equal(t, "03:42:31.000000876", string(b))
+	assert.NoError(t, tm0.UnmarshalCQL(textType, b))
+	assert.Equal(t, tm, tm0)
+
+	assert.NoError(t, tm0.UnmarshalCQL(timeType, nil))
+	assert.Equal(t, Time{}, tm0)
+}
+
+/* === ERRORS === */
+
+func TestMarshal_Errors(t *testing.T) {
+	_, err := Date{Year: 2020, Month: 2, Day: 29}.MarshalCQL(intType)
+	assert.True(t, errors.Is(err, civil.ErrUnsupportedType))
+	_, err = Date{Year: 9999999, Month: 1, Day: 1}.MarshalCQL(dateType)
+	assert.True(t, errors.Is(err, civil.ErrYearOutOfRange))
+	_, err = Time{}.MarshalCQL(dateType)
+	assert.True(t, errors.Is(err, civil.ErrUnsupportedType))
+
+	for _, tm := range []Time{{Hour: 24}, {Hour: 3, Minute: 60}, {Nanosecond: -1}} {
+		_, err = tm.MarshalCQL(timeType)
+		assert.True(t, errors.Is(err, civil.ErrInvalidTime), tm)
+		_, err = tm.MarshalCQL(textType)
+		assert.True(t, errors.Is(err, civil.ErrInvalidTime), tm)
+	}
+}
+
+func TestUnmarshal_Errors(t *testing.T) {
+	d := Date{Year: 2020, Month: 2, Day: 29}
+	assert.True(t, errors.Is(d.UnmarshalCQL(dateType, []byte{0x80}), civil.ErrInvalidFormat))
+	assert.True(t, errors.Is(d.UnmarshalCQL(intType, []byte{0, 0, 0, 1}), civil.ErrUnsupportedType))
+	assert.Error(t, d.UnmarshalCQL(textType, []byte("2020-02-30")))
+	assert.Equal(t, Date{Year: 2020, Month: 2, Day: 29}, d)
+
+	var tm Time
+	assert.True(t, errors.Is(tm.UnmarshalCQL(timeType, []byte{0, 0, 0, 1}), civil.ErrInvalidFormat))
+	assert.True(t, errors.Is(tm.UnmarshalCQL(timeType, []byte{0, 0, 0x4e, 0x94, 0x91, 0x4f, 0, 0}), civil.ErrInvalidTime))
+	assert.True(t, errors.Is(tm.UnmarshalCQL(timeType, []byte{0xff, 0, 0, 0, 0, 0, 0, 0}), civil.ErrInvalidTime))
+	assert.True(t, errors.Is(tm.UnmarshalCQL(dateType, []byte{0, 0, 0, 1}), civil.ErrUnsupportedType))
+}
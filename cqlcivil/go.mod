@@ -0,0 +1,21 @@
+module github.com/openlyinc/civil/cqlcivil
+
+go 1.19
+
+require (
+	github.com/gocql/gocql v1.7.0
+	github.com/openlyinc/civil v0.0.0
+	github.com/stretchr/testify v1.4.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/golang/snappy v0.0.3 // indirect
+	github.com/hailocab/go-hostpool v0.0.0-20160125115350-e80d13ce29ed // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/inf.v0 v0.9.1 // indirect
+	gopkg.in/yaml.v2 v2.2.2 // indirect
+)
+
+replace github.com/openlyinc/civil => ../
@@ -0,0 +1,153 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// A DateRange is the range of dates from Start to End inclusive, so that
+// DateRange{d, d} is the single day d, as in a booking or a billing period
+// from its first day to its last. A range whose End is before its Start is
+// empty, and is not valid.
+type DateRange struct {
+	Start Date // The first day of the range.
+	End   Date // The last day of the range.
+}
+
+// NewDateRange returns the range from start to end inclusive. It returns an
+// error as for NewDate if either is not a valid date, and an error wrapping
+// ErrInvalidRange if end is before start.
+func NewDateRange(start, end Date) (DateRange, error) {
+	r := DateRange{Start: start, End: end}
+	if err := r.Validate(); err != nil {
+		return DateRange{}, err
+	}
+	return r, nil
+}
+
+// Validate returns an error as for NewDate if Start or End is not a valid
+// date, and an error wrapping ErrInvalidRange if End is before Start.
+func (r DateRange) Validate() error {
+	if err := r.Start.Validate(); err != nil {
+		return err
+	}
+	if err := r.End.Validate(); err != nil {
+		return err
+	}
+	if r.End.Before(r.Start) {
+		return fmt.Errorf("%w: %v ends before it starts", ErrInvalidRange, r)
+	}
+	return nil
+}
+
+// IsValid reports whether Start and End are valid dates and End is not
+// before Start.
+func (r DateRange) IsValid() bool {
+	return r.Validate() == nil
+}
+
+// IsEmpty reports whether the range contains no dates, because End is
+// before Start.
+func (r DateRange) IsEmpty() bool {
+	return r.End.Before(r.Start)
+}
+
+// String returns the range in ISO 8601 interval form, such as
+// "2020-02-01/2020-02-29".
+func (r DateRange) String() string {
+	return r.Start.String() + "/" + r.End.String()
+}
+
+// Days returns the number of dates in the range, counting both Start and
+// End, or 0 if the range is empty.
+func (r DateRange) Days() int {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.End.DaysSince(r.Start) + 1
+}
+
+// Contains reports whether d is in the range.
+func (r DateRange) Contains(d Date) bool {
+	return !d.Before(r.Start) && !d.After(r.End)
+}
+
+// ContainsRange reports whether every date of o is in r. An empty o is
+// contained in any range.
+func (r DateRange) ContainsRange(o DateRange) bool {
+	return o.IsEmpty() || !o.Start.Before(r.Start) && !o.End.After(r.End)
+}
+
+// Overlaps reports whether r and o have at least one date in common.
+func (r DateRange) Overlaps(o DateRange) bool {
+	_, ok := r.Intersect(o)
+	return ok
+}
+
+// Intersect returns the dates that r and o have in common, and whether
+// there are any.
+func (r DateRange) Intersect(o DateRange) (DateRange, bool) {
+	i := r
+	if o.Start.After(i.Start) {
+		i.Start = o.Start
+	}
+	if o.End.Before(i.End) {
+		i.End = o.End
+	}
+	if i.IsEmpty() {
+		return DateRange{}, false
+	}
+	return i, true
+}
+
+// SplitByMonth returns the range split at the boundaries of calendar months,
+// so that each part lies within a single month, as for prorating a charge
+// by month. The first and last parts may be partial months. It returns nil
+// for an empty range.
+func (r DateRange) SplitByMonth() []DateRange {
+	return r.split(Date.EndOfMonth)
+}
+
+// SplitByWeek returns the range split at the boundaries of weeks beginning
+// on weekStart, such as time.Monday for ISO 8601 weeks or time.Sunday for US
+// weeks. The first and last parts may be partial weeks. It returns nil for
+// an empty range.
+func (r DateRange) SplitByWeek(weekStart time.Weekday) []DateRange {
+	return r.split(func(d Date) Date { return d.EndOfWeek(weekStart) })
+}
+
+// SplitByYear returns the range split at the boundaries of calendar years.
+// The first and last parts may be partial years. It returns nil for an
+// empty range.
+func (r DateRange) SplitByYear() []DateRange {
+	return r.split(Date.EndOfYear)
+}
+
+// split returns the range split into parts that each end on the date that
+// end returns for their start, or on r.End.
+func (r DateRange) split(end func(Date) Date) []DateRange {
+	var parts []DateRange
+	for start := r.Start; !start.After(r.End); {
+		e := end(start)
+		if e.After(r.End) {
+			e = r.End
+		}
+		parts = append(parts, DateRange{Start: start, End: e})
+		start = e.AddDays(1)
+	}
+	return parts
+}
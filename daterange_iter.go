@@ -0,0 +1,66 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+// +build go1.23
+
+package civil
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// Dates returns an iterator over the dates of the range in order, from
+// Start to End inclusive:
+//
+//	for d := range r.Dates() {
+//		...
+//	}
+func (r DateRange) Dates() iter.Seq[Date] {
+	return r.Every(1)
+}
+
+// Every returns an iterator over every nth date of the range, starting with
+// Start, so that Every(7) yields the dates one week apart. If n < 1 it
+// yields no dates.
+func (r DateRange) Every(n int) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		if n < 1 {
+			return
+		}
+		for d := r.Start; !d.After(r.End); d = d.AddDays(n) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// Weekdays returns an iterator over the dates of the range that fall on one
+// of the given days of the week, which are Monday to Friday if none are
+// given.
+func (r DateRange) Weekdays(days ...time.Weekday) iter.Seq[Date] {
+	if len(days) == 0 {
+		days = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	}
+	return func(yield func(Date) bool) {
+		for d := range r.Dates() {
+			if slices.Contains(days, d.Weekday()) && !yield(d) {
+				return
+			}
+		}
+	}
+}
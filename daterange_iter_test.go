@@ -0,0 +1,73 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+// +build go1.23
+
+package civil
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateRange_Dates(t *testing.T) {
+	r := DateRange{Date{2020, 2, 27}, Date{2020, 3, 2}}
+	assert.Equal(t, []Date{
+		{2020, 2, 27}, {2020, 2, 28}, {2020, 2, 29}, {2020, 3, 1}, {2020, 3, 2},
+	}, slices.Collect(r.Dates()))
+
+	var got []Date
+	for d := range r.Dates() {
+		if d.Month == time.March {
+			break
+		}
+		got = append(got, d)
+	}
+	assert.Equal(t, []Date{{2020, 2, 27}, {2020, 2, 28}, {2020, 2, 29}}, got)
+
+	assert.Empty(t, slices.Collect(DateRange{Date{2020, 3, 1}, Date{2020, 2, 29}}.Dates()))
+	assert.Equal(t, []Date{{2020, 2, 29}}, slices.Collect(DateRange{Date{2020, 2, 29}, Date{2020, 2, 29}}.Dates()))
+}
+
+func TestDateRange_Every(t *testing.T) {
+	r := DateRange{Date{2020, 2, 1}, Date{2020, 2, 29}}
+	assert.Equal(t, []Date{
+		{2020, 2, 1}, {2020, 2, 8}, {2020, 2, 15}, {2020, 2, 22}, {2020, 2, 29},
+	}, slices.Collect(r.Every(7)))
+	assert.Equal(t, []Date{{2020, 2, 1}, {2020, 2, 11}, {2020, 2, 21}}, slices.Collect(r.Every(10)))
+	assert.Empty(t, slices.Collect(r.Every(0)))
+	assert.Empty(t, slices.Collect(r.Every(-1)))
+}
+
+func TestDateRange_Weekdays(t *testing.T) {
+	// 2020-02-24 is a Monday.
+	r := DateRange{Date{2020, 2, 22}, Date{2020, 3, 2}}
+	assert.Equal(t, []Date{
+		{2020, 2, 24}, {2020, 2, 25}, {2020, 2, 26}, {2020, 2, 27}, {2020, 2, 28}, {2020, 3, 2},
+	}, slices.Collect(r.Weekdays()))
+	assert.Equal(t, []Date{{2020, 2, 22}, {2020, 2, 29}}, slices.Collect(r.Weekdays(time.Saturday)))
+
+	var got []Date
+	for d := range r.Weekdays() {
+		got = append(got, d)
+		if len(got) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []Date{{2020, 2, 24}, {2020, 2, 25}}, got)
+}
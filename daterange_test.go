@@ -0,0 +1,146 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateRange(t *testing.T) {
+	feb := DateRange{Date{2020, 2, 1}, Date{2020, 2, 29}}
+	assert.True(t, feb.IsValid())
+	assert.False(t, feb.IsEmpty())
+	assert.Equal(t, 29, feb.Days())
+	assert.Equal(t, "2020-02-01/2020-02-29", feb.String())
+
+	assert.True(t, feb.Contains(Date{2020, 2, 1}))
+	assert.True(t, feb.Contains(Date{2020, 2, 29}))
+	assert.False(t, feb.Contains(Date{2020, 1, 31}))
+	assert.False(t, feb.Contains(Date{2020, 3, 1}))
+
+	day := DateRange{Date{2020, 2, 29}, Date{2020, 2, 29}}
+	assert.Equal(t, 1, day.Days())
+	assert.True(t, feb.ContainsRange(day))
+	assert.False(t, day.ContainsRange(feb))
+
+	empty := DateRange{Date{2020, 3, 1}, Date{2020, 2, 29}}
+	assert.True(t, empty.IsEmpty())
+	assert.False(t, empty.IsValid())
+	assert.Equal(t, 0, empty.Days())
+	assert.True(t, feb.ContainsRange(empty))
+
+	r, err := NewDateRange(Date{2020, 2, 1}, Date{2020, 2, 29})
+	assert.NoError(t, err)
+	assert.Equal(t, feb, r)
+}
+
+func TestDateRange_Intersect(t *testing.T) {
+	type TC struct {
+		A, B    DateRange
+		Out     DateRange
+		Overlap bool
+	}
+	tcs := []TC{
+		TC{
+			A:       DateRange{Date{2020, 1, 15}, Date{2020, 2, 15}},
+			B:       DateRange{Date{2020, 2, 1}, Date{2020, 2, 29}},
+			Out:     DateRange{Date{2020, 2, 1}, Date{2020, 2, 15}},
+			Overlap: true,
+		},
+		TC{
+			A:       DateRange{Date{2020, 1, 1}, Date{2020, 12, 31}},
+			B:       DateRange{Date{2020, 2, 1}, Date{2020, 2, 29}},
+			Out:     DateRange{Date{2020, 2, 1}, Date{2020, 2, 29}},
+			Overlap: true,
+		},
+		TC{
+			A:       DateRange{Date{2020, 1, 1}, Date{2020, 1, 31}},
+			B:       DateRange{Date{2020, 1, 31}, Date{2020, 2, 29}},
+			Out:     DateRange{Date{2020, 1, 31}, Date{2020, 1, 31}},
+			Overlap: true,
+		},
+		TC{
+			A: DateRange{Date{2020, 1, 1}, Date{2020, 1, 31}},
+			B: DateRange{Date{2020, 2, 1}, Date{2020, 2, 29}},
+		},
+		TC{
+			A: DateRange{Date{2020, 1, 1}, Date{2020, 1, 31}},
+			B: DateRange{Date{2020, 1, 20}, Date{2020, 1, 10}},
+		},
+	}
+	for _, tc := range tcs {
+		for _, pair := range [][2]DateRange{{tc.A, tc.B}, {tc.B, tc.A}} {
+			out, ok := pair[0].Intersect(pair[1])
+			assert.Equal(t, tc.Overlap, ok, "%v and %v", pair[0], pair[1])
+			assert.Equal(t, tc.Out, out, "%v and %v", pair[0], pair[1])
+			assert.Equal(t, tc.Overlap, pair[0].Overlaps(pair[1]), "%v and %v", pair[0], pair[1])
+		}
+	}
+}
+
+func TestDateRange_Split(t *testing.T) {
+	r := DateRange{Date{2019, 12, 15}, Date{2020, 3, 10}}
+	assert.Equal(t, []DateRange{
+		{Date{2019, 12, 15}, Date{2019, 12, 31}},
+		{Date{2020, 1, 1}, Date{2020, 1, 31}},
+		{Date{2020, 2, 1}, Date{2020, 2, 29}},
+		{Date{2020, 3, 1}, Date{2020, 3, 10}},
+	}, r.SplitByMonth())
+	assert.Equal(t, []DateRange{
+		{Date{2019, 12, 15}, Date{2019, 12, 31}},
+		{Date{2020, 1, 1}, Date{2020, 3, 10}},
+	}, r.SplitByYear())
+
+	// 2020-02-24 is a Monday.
+	w := DateRange{Date{2020, 2, 20}, Date{2020, 3, 4}}
+	assert.Equal(t, []DateRange{
+		{Date{2020, 2, 20}, Date{2020, 2, 23}},
+		{Date{2020, 2, 24}, Date{2020, 3, 1}},
+		{Date{2020, 3, 2}, Date{2020, 3, 4}},
+	}, w.SplitByWeek(time.Monday))
+	assert.Equal(t, []DateRange{
+		{Date{2020, 2, 20}, Date{2020, 2, 22}},
+		{Date{2020, 2, 23}, Date{2020, 2, 29}},
+		{Date{2020, 3, 1}, Date{2020, 3, 4}},
+	}, w.SplitByWeek(time.Sunday))
+
+	day := DateRange{Date{2020, 2, 29}, Date{2020, 2, 29}}
+	assert.Equal(t, []DateRange{day}, day.SplitByMonth())
+	assert.Equal(t, []DateRange{day}, day.SplitByWeek(time.Monday))
+	assert.Equal(t, []DateRange{day}, day.SplitByYear())
+
+	month := DateRange{Date{2020, 2, 1}, Date{2020, 2, 29}}
+	assert.Equal(t, []DateRange{month}, month.SplitByMonth())
+
+	assert.Nil(t, DateRange{Date{2020, 3, 1}, Date{2020, 2, 29}}.SplitByMonth())
+}
+
+/* === ERRORS === */
+
+func TestNewDateRange_Errors(t *testing.T) {
+	_, err := NewDateRange(Date{2020, 3, 1}, Date{2020, 2, 29})
+	assert.True(t, errors.Is(err, ErrInvalidRange))
+	assert.EqualError(t, err, "civil: invalid range: 2020-03-01/2020-02-29 ends before it starts")
+
+	_, err = NewDateRange(Date{2021, 2, 29}, Date{2021, 3, 1})
+	assert.True(t, errors.Is(err, ErrInvalidDay))
+
+	_, err = NewDateRange(Date{2021, 2, 1}, Date{2021, 13, 1})
+	assert.True(t, errors.Is(err, ErrInvalidMonth))
+}
@@ -0,0 +1,188 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"sort"
+	"strings"
+)
+
+// A DateRangeSet is a set of dates held as the sorted list of disjoint
+// ranges that cover them, such as the days a room is available or the
+// blackout dates of a promotion. Adjacent ranges are merged, so the set of
+// January and February 2020 holds the single range 2020-01-01/2020-02-29
+// however it was built. The zero value is the empty set.
+//
+// The methods never modify a slice that another DateRangeSet may share, so
+// a DateRangeSet can be copied by assignment.
+type DateRangeSet struct {
+	ranges []DateRange
+}
+
+// NewDateRangeSet returns the set of the dates in the given ranges, which
+// may overlap and be in any order. Empty ranges are ignored.
+func NewDateRangeSet(ranges ...DateRange) DateRangeSet {
+	var s DateRangeSet
+	for _, r := range ranges {
+		s.Add(r)
+	}
+	return s
+}
+
+// Ranges returns the disjoint ranges of the set in order.
+func (s DateRangeSet) Ranges() []DateRange {
+	return append([]DateRange(nil), s.ranges...)
+}
+
+// IsEmpty reports whether the set contains no dates.
+func (s DateRangeSet) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// Days returns the number of dates in the set.
+func (s DateRangeSet) Days() int {
+	n := 0
+	for _, r := range s.ranges {
+		n += r.Days()
+	}
+	return n
+}
+
+// String returns the ranges of the set in ISO 8601 interval form, separated
+// by commas and enclosed in braces.
+func (s DateRangeSet) String() string {
+	parts := make([]string, len(s.ranges))
+	for i, r := range s.ranges {
+		parts[i] = r.String()
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// Contains reports whether d is in the set.
+func (s DateRangeSet) Contains(d Date) bool {
+	i := sort.Search(len(s.ranges), func(i int) bool {
+		return !s.ranges[i].End.Before(d)
+	})
+	return i < len(s.ranges) && !d.Before(s.ranges[i].Start)
+}
+
+// ContainsRange reports whether every date of r is in the set. An empty r
+// is contained in any set.
+func (s DateRangeSet) ContainsRange(r DateRange) bool {
+	if r.IsEmpty() {
+		return true
+	}
+	i := sort.Search(len(s.ranges), func(i int) bool {
+		return !s.ranges[i].End.Before(r.Start)
+	})
+	return i < len(s.ranges) && s.ranges[i].ContainsRange(r)
+}
+
+// span returns the indexes [lo,hi) of the ranges of s that have a date in
+// common with r, or, if adjacent is set, that are next to it.
+func (s DateRangeSet) span(r DateRange, adjacent bool) (lo, hi int) {
+	start, end := r.Start, r.End
+	if adjacent {
+		start, end = start.AddDays(-1), end.AddDays(1)
+	}
+	lo = sort.Search(len(s.ranges), func(i int) bool {
+		return !s.ranges[i].End.Before(start)
+	})
+	hi = sort.Search(len(s.ranges), func(i int) bool {
+		return s.ranges[i].Start.After(end)
+	})
+	return lo, hi
+}
+
+// replace sets the ranges of s to those of s with [lo,hi) replaced by with.
+func (s *DateRangeSet) replace(lo, hi int, with ...DateRange) {
+	ranges := make([]DateRange, 0, len(s.ranges)-(hi-lo)+len(with))
+	ranges = append(ranges, s.ranges[:lo]...)
+	ranges = append(ranges, with...)
+	ranges = append(ranges, s.ranges[hi:]...)
+	s.ranges = ranges
+}
+
+// Add adds the dates of r to the set.
+func (s *DateRangeSet) Add(r DateRange) {
+	if r.IsEmpty() {
+		return
+	}
+	lo, hi := s.span(r, true)
+	if lo < hi {
+		if s.ranges[lo].Start.Before(r.Start) {
+			r.Start = s.ranges[lo].Start
+		}
+		if s.ranges[hi-1].End.After(r.End) {
+			r.End = s.ranges[hi-1].End
+		}
+	}
+	s.replace(lo, hi, r)
+}
+
+// Subtract removes the dates of r from the set.
+func (s *DateRangeSet) Subtract(r DateRange) {
+	if r.IsEmpty() {
+		return
+	}
+	lo, hi := s.span(r, false)
+	if lo == hi {
+		return
+	}
+	var rest []DateRange
+	if first := s.ranges[lo]; first.Start.Before(r.Start) {
+		rest = append(rest, DateRange{Start: first.Start, End: r.Start.AddDays(-1)})
+	}
+	if last := s.ranges[hi-1]; last.End.After(r.End) {
+		rest = append(rest, DateRange{Start: r.End.AddDays(1), End: last.End})
+	}
+	s.replace(lo, hi, rest...)
+}
+
+// Union returns the set of the dates in s or o.
+func (s DateRangeSet) Union(o DateRangeSet) DateRangeSet {
+	for _, r := range o.ranges {
+		s.Add(r)
+	}
+	return s
+}
+
+// Difference returns the set of the dates in s but not in o.
+func (s DateRangeSet) Difference(o DateRangeSet) DateRangeSet {
+	for _, r := range o.ranges {
+		s.Subtract(r)
+	}
+	return s
+}
+
+// Intersect returns the set of the dates in both s and o.
+func (s DateRangeSet) Intersect(o DateRangeSet) DateRangeSet {
+	var out DateRangeSet
+	for _, r := range s.ranges {
+		lo, hi := o.span(r, false)
+		for _, p := range o.ranges[lo:hi] {
+			if i, ok := r.Intersect(p); ok {
+				out.ranges = append(out.ranges, i)
+			}
+		}
+	}
+	return out
+}
+
+// Complement returns the set of the dates within bounds that are not in s,
+// such as the days a room is booked given the days it is free.
+func (s DateRangeSet) Complement(bounds DateRange) DateRangeSet {
+	return NewDateRangeSet(bounds).Difference(s)
+}
@@ -0,0 +1,129 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// febDay returns the date of day n of February 2020, counting on into March.
+func febDay(n int) Date {
+	return Date{2020, 2, 1}.AddDays(n - 1)
+}
+
+// febDays returns the range from febDay(start) to febDay(end).
+func febDays(start, end int) DateRange {
+	return DateRange{febDay(start), febDay(end)}
+}
+
+func TestDateRangeSet_Add(t *testing.T) {
+	type TC struct {
+		In  []DateRange
+		Out string
+	}
+	tcs := []TC{
+		TC{In: nil, Out: "{}"},
+		TC{In: []DateRange{febDays(5, 1)}, Out: "{}"},
+		TC{In: []DateRange{febDays(10, 12), febDays(1, 3)}, Out: "{2020-02-01/2020-02-03, 2020-02-10/2020-02-12}"},
+		TC{In: []DateRange{febDays(1, 3), febDays(4, 6)}, Out: "{2020-02-01/2020-02-06}"},
+		TC{In: []DateRange{febDays(1, 3), febDays(5, 6), febDays(3, 5)}, Out: "{2020-02-01/2020-02-06}"},
+		TC{In: []DateRange{febDays(1, 3), febDays(10, 12), febDays(20, 22), febDays(2, 21)}, Out: "{2020-02-01/2020-02-22}"},
+		TC{In: []DateRange{febDays(1, 10), febDays(3, 4)}, Out: "{2020-02-01/2020-02-10}"},
+		TC{In: []DateRange{febDays(25, 32)}, Out: "{2020-02-25/2020-03-03}"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, NewDateRangeSet(tc.In...).String(), "%v", tc.In)
+	}
+}
+
+func TestDateRangeSet_Subtract(t *testing.T) {
+	s := NewDateRangeSet(febDays(1, 10), febDays(20, 29))
+	s.Subtract(febDays(5, 5))
+	assert.Equal(t, "{2020-02-01/2020-02-04, 2020-02-06/2020-02-10, 2020-02-20/2020-02-29}", s.String())
+	s.Subtract(febDays(8, 22))
+	assert.Equal(t, "{2020-02-01/2020-02-04, 2020-02-06/2020-02-07, 2020-02-23/2020-02-29}", s.String())
+	s.Subtract(febDays(11, 12))
+	assert.Equal(t, "{2020-02-01/2020-02-04, 2020-02-06/2020-02-07, 2020-02-23/2020-02-29}", s.String())
+	s.Subtract(febDays(1, 29))
+	assert.True(t, s.IsEmpty())
+}
+
+func TestDateRangeSet_Copy(t *testing.T) {
+	a := NewDateRangeSet(febDays(1, 10), febDays(20, 29))
+	b := a
+	b.Subtract(febDays(5, 25))
+	b.Add(febDays(12, 14))
+	assert.Equal(t, "{2020-02-01/2020-02-10, 2020-02-20/2020-02-29}", a.String())
+	assert.Equal(t, "{2020-02-01/2020-02-04, 2020-02-12/2020-02-14, 2020-02-26/2020-02-29}", b.String())
+
+	r := a.Ranges()
+	r[0].End = febDay(2)
+	assert.Equal(t, 20, a.Days())
+}
+
+func TestDateRangeSet_Operations(t *testing.T) {
+	a := NewDateRangeSet(febDays(1, 10), febDays(20, 29))
+	b := NewDateRangeSet(febDays(5, 22))
+	assert.Equal(t, "{2020-02-01/2020-02-29}", a.Union(b).String())
+	assert.Equal(t, "{2020-02-01/2020-02-04, 2020-02-23/2020-02-29}", a.Difference(b).String())
+	assert.Equal(t, "{2020-02-11/2020-02-19}", b.Difference(a).String())
+	assert.Equal(t, "{2020-02-05/2020-02-10, 2020-02-20/2020-02-22}", a.Intersect(b).String())
+	assert.Equal(t, "{2020-02-11/2020-02-19, 2020-03-01/2020-03-05}", a.Complement(febDays(3, 34)).String())
+	assert.Equal(t, "{}", a.Complement(febDays(2, 8)).String())
+
+	assert.True(t, a.Contains(febDay(1)))
+	assert.True(t, a.Contains(febDay(10)))
+	assert.False(t, a.Contains(febDay(11)))
+	assert.False(t, a.Contains(febDay(30)))
+	assert.True(t, a.ContainsRange(febDays(20, 29)))
+	assert.False(t, a.ContainsRange(febDays(9, 20)))
+	assert.True(t, a.ContainsRange(febDays(9, 8)))
+	assert.Equal(t, 20, a.Days())
+}
+
+func TestDateRangeSet_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	var s DateRangeSet
+	in := make(map[int]bool)
+	for i := 0; i < 500; i++ {
+		start := rnd.Intn(60)
+		end := start + rnd.Intn(8)
+		add := rnd.Intn(3) != 0
+		if add {
+			s.Add(febDays(start, end))
+		} else {
+			s.Subtract(febDays(start, end))
+		}
+		for n := start; n <= end; n++ {
+			in[n] = add
+		}
+
+		ranges := s.Ranges()
+		for j := 1; j < len(ranges); j++ {
+			assert.True(t, ranges[j].Start.After(ranges[j-1].End.AddDays(1)), "%v", s)
+		}
+		count := 0
+		for n := -1; n < 70; n++ {
+			if in[n] {
+				count++
+			}
+			assert.Equal(t, in[n], s.Contains(febDay(n)), "%v %v", febDay(n), s)
+		}
+		assert.Equal(t, count, s.Days())
+	}
+}
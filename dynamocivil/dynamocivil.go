@@ -0,0 +1,181 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dynamocivil stores civil values in DynamoDB through the
+// attributevalue package of aws-sdk-go-v2.
+//
+// The civil types implement encoding.TextMarshaler, so the simplest setup
+// is to let attributevalue use it, which stores them as string attributes
+// such as "2020-02-29" without any change to the item structs:
+//
+//	item, err := attributevalue.MarshalMapWithOptions(v, dynamocivil.EncoderOptions)
+//	err = attributevalue.UnmarshalMapWithOptions(item, &v, dynamocivil.DecoderOptions)
+//
+// Alternatively, declare fields with the types of this package, which
+// implement attributevalue.Marshaler and Unmarshaler themselves. EpochDays
+// stores a date as a number attribute counting days since 1970-01-01,
+// which sorts and compares numerically in key conditions.
+package dynamocivil
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/openlyinc/civil"
+)
+
+// EncoderOptions makes an attributevalue.Encoder marshal civil values, and
+// any other encoding.TextMarshaler, as string attributes.
+func EncoderOptions(o *attributevalue.EncoderOptions) {
+	o.UseEncodingMarshalers = true
+}
+
+// DecoderOptions makes an attributevalue.Decoder unmarshal string
+// attributes into civil values, and any other encoding.TextUnmarshaler.
+func DecoderOptions(o *attributevalue.DecoderOptions) {
+	o.UseEncodingUnmarshalers = true
+}
+
+// unixEpoch is the date from which EpochDays counts.
+var unixEpoch = civil.Date{Year: 1970, Month: 1, Day: 1}
+
+// Date is a civil.Date stored as a string attribute.
+type Date civil.Date
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler
+// interface.
+func (d Date) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return &types.AttributeValueMemberS{Value: civil.Date(d).String()}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements the
+// attributevalue.Unmarshaler interface. A NULL attribute gives the zero
+// value.
+func (d *Date) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	s, err := stringValue(av, "civil.Date")
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		*d = Date{}
+		return nil
+	}
+	var val civil.Date
+	if err := val.UnmarshalText([]byte(*s)); err != nil {
+		return err
+	}
+	*d = Date(val)
+	return nil
+}
+
+// EpochDays is a civil.Date stored as a number attribute holding the days
+// since 1970-01-01.
+type EpochDays civil.Date
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler
+// interface.
+func (d EpochDays) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	days := civil.Date(d).DaysSince(unixEpoch)
+	return &types.AttributeValueMemberN{Value: strconv.Itoa(days)}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements the
+// attributevalue.Unmarshaler interface. A NULL attribute gives the zero
+// value.
+func (d *EpochDays) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	switch av := av.(type) {
+	case *types.AttributeValueMemberNULL:
+		*d = EpochDays{}
+		return nil
+	case *types.AttributeValueMemberN:
+		days, err := strconv.Atoi(av.Value)
+		if err != nil {
+			return fmt.Errorf("%w: %q is not a number of days", civil.ErrInvalidFormat, av.Value)
+		}
+		*d = EpochDays(unixEpoch.AddDays(days))
+		return nil
+	}
+	return fmt.Errorf("%w: cannot unmarshal %T into civil.Date", civil.ErrUnsupportedType, av)
+}
+
+// Time is a civil.Time stored as a string attribute.
+type Time civil.Time
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler
+// interface.
+func (t Time) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return &types.AttributeValueMemberS{Value: civil.Time(t).String()}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements the
+// attributevalue.Unmarshaler interface. A NULL attribute gives the zero
+// value.
+func (t *Time) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	s, err := stringValue(av, "civil.Time")
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		*t = Time{}
+		return nil
+	}
+	var val civil.Time
+	if err := val.UnmarshalText([]byte(*s)); err != nil {
+		return err
+	}
+	*t = Time(val)
+	return nil
+}
+
+// DateTime is a civil.DateTime stored as a string attribute.
+type DateTime civil.DateTime
+
+// MarshalDynamoDBAttributeValue implements the attributevalue.Marshaler
+// interface.
+func (dt DateTime) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return &types.AttributeValueMemberS{Value: civil.DateTime(dt).String()}, nil
+}
+
+// UnmarshalDynamoDBAttributeValue implements the
+// attributevalue.Unmarshaler interface. A NULL attribute gives the zero
+// value.
+func (dt *DateTime) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	s, err := stringValue(av, "civil.DateTime")
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		*dt = DateTime{}
+		return nil
+	}
+	var val civil.DateTime
+	if err := val.UnmarshalText([]byte(*s)); err != nil {
+		return err
+	}
+	*dt = DateTime(val)
+	return nil
+}
+
+// stringValue returns the value of a string attribute, or nil for NULL.
+func stringValue(av types.AttributeValue, typeName string) (*string, error) {
+	switch av := av.(type) {
+	case *types.AttributeValueMemberNULL:
+		return nil, nil
+	case *types.AttributeValueMemberS:
+		return &av.Value, nil
+	}
+	return nil, fmt.Errorf("%w: cannot unmarshal %T into %s", civil.ErrUnsupportedType, av, typeName)
+}
@@ -0,0 +1,131 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dynamocivil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/openlyinc/civil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ attributevalue.Marshaler   = Date{}
+	_ attributevalue.Unmarshaler = (*Date)(nil)
+	_ attributevalue.Marshaler   = EpochDays{}
+	_ attributevalue.Unmarshaler = (*EpochDays)(nil)
+	_ attributevalue.Marshaler   = Time{}
+	_ attributevalue.Unmarshaler = (*Time)(nil)
+	_ attributevalue.Marshaler   = DateTime{}
+	_ attributevalue.Unmarshaler = (*DateTime)(nil)
+)
+
+func TestMarshal_RoundTrip(t *testing.T) {
+	type TC struct {
+		In  attributevalue.Marshaler
+		Out types.AttributeValue
+		New func() attributevalue.Unmarshaler
+	}
+	tcs := []TC{
+		TC{
+			In:  Date{Year: 2020, Month: 2, Day: 29},
+			Out: &types.AttributeValueMemberS{Value: "2020-02-29"},
+			New: func() attributevalue.Unmarshaler { return new(Date) },
+		},
+		TC{
+			In:  EpochDays{Year: 2020, Month: 2, Day: 29},
+			Out: &types.AttributeValueMemberN{Value: "18321"},
+			New: func() attributevalue.Unmarshaler { return new(EpochDays) },
+		},
+		TC{
+			In:  EpochDays{Year: 1969, Month: 12, Day: 31},
+			Out: &types.AttributeValueMemberN{Value: "-1"},
+			New: func() attributevalue.Unmarshaler { return new(EpochDays) },
+		},
+		TC{
+			In:  Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876},
+			Out: &types.AttributeValueMemberS{Value: "03:42:31.000000876"},
+			New: func() attributevalue.Unmarshaler { return new(Time) },
+		},
+		TC{
+			In: DateTime{
+				Date: civil.Date{Year: 2020, Month: 2, Day: 29},
+				Time: civil.Time{Hour: 3, Minute: 42, Second: 31},
+			},
+			Out: &types.AttributeValueMemberS{Value: "2020-02-29T03:42:31"},
+			New: func() attributevalue.Unmarshaler { return new(DateTime) },
+		},
+	}
+	for _, tc := range tcs {
+		av, err := tc.In.MarshalDynamoDBAttributeValue()
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, av, tc.In)
+
+		u := tc.New()
+		assert.NoError(t, u.UnmarshalDynamoDBAttributeValue(av), tc.In)
+		assert.Equal(t, tc.In, derefUnmarshaler(u), tc.In)
+
+		// NULL gives the zero value.
+		assert.NoError(t, u.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberNULL{Value: true}), tc.In)
+		assert.NotEqual(t, tc.In, derefUnmarshaler(u), tc.In)
+	}
+}
+
+func derefUnmarshaler(u attributevalue.Unmarshaler) interface{} {
+	switch u := u.(type) {
+	case *Date:
+		return *u
+	case *EpochDays:
+		return *u
+	case *Time:
+		return *u
+	case *DateTime:
+		return *u
+	}
+	return nil
+}
+
+func TestOptions(t *testing.T) {
+	var eo attributevalue.EncoderOptions
+	EncoderOptions(&eo)
+	assert.True(t, eo.UseEncodingMarshalers)
+
+	var do attributevalue.DecoderOptions
+	DecoderOptions(&do)
+	assert.True(t, do.UseEncodingUnmarshalers)
+}
+
+/* === ERRORS === */
+
+func TestUnmarshal_Errors(t *testing.T) {
+	d := Date{Year: 2020, Month: 2, Day: 29}
+	err := d.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberN{Value: "18321"})
+	assert.True(t, errors.Is(err, civil.ErrUnsupportedType))
+	assert.EqualError(t, err, "civil: unsupported type: cannot unmarshal *types.AttributeValueMemberN into civil.Date")
+	assert.Error(t, d.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberS{Value: "2020-02-30"}))
+	assert.Equal(t, Date{Year: 2020, Month: 2, Day: 29}, d)
+
+	var e EpochDays
+	assert.True(t, errors.Is(e.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberS{Value: "2020-02-29"}), civil.ErrUnsupportedType))
+	assert.True(t, errors.Is(e.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberN{Value: "1.5"}), civil.ErrInvalidFormat))
+
+	var tm Time
+	assert.True(t, errors.Is(tm.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberBOOL{Value: true}), civil.ErrUnsupportedType))
+	var dt DateTime
+	assert.Error(t, dt.UnmarshalDynamoDBAttributeValue(&types.AttributeValueMemberS{Value: "2020-02-29"}))
+}
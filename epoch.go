@@ -0,0 +1,96 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// EpochMillisDateTime is a DateTime that is marshaled to JSON as the
+// integer number of milliseconds since 1970-01-01T00:00:00, for APIs that
+// expect numeric timestamps. Like a DateTime it has no location: the count
+// is of wall-clock milliseconds, as if the datetime were in UTC. Fractions
+// of a millisecond are truncated toward the past.
+//
+// Convert with EpochMillisDateTime(dt) and DateTime(v).
+type EpochMillisDateTime DateTime
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v EpochMillisDateTime) MarshalJSON() ([]byte, error) {
+	ms, _ := DateTime(v).localTimestamp(time.Millisecond)
+	return strconv.AppendInt(nil, ms, 10), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. It
+// accepts a JSON integer, and leaves v unchanged for null.
+func (v *EpochMillisDateTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	ms, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: epoch milliseconds should be an integer, got %s", ErrInvalidFormat, data)
+	}
+	*v = EpochMillisDateTime(dateTimeFromLocalTimestamp(ms, time.Millisecond))
+	return nil
+}
+
+// String returns the datetime in the format written by DateTime.String.
+func (v EpochMillisDateTime) String() string {
+	return DateTime(v).String()
+}
+
+// DaysSinceEpoch returns the number of days from 1970-01-01 to d, negative
+// for earlier dates. It is computed with integer arithmetic alone, so it is
+// cheap enough to use as a sort or comparison key, and suits the day counts
+// of Arrow, Avro and ClickHouse date columns. Days past the end of the month
+// count on into the next, as for AddDays.
+func (d Date) DaysSinceEpoch() int64 {
+	// Count years from March, so that the leap day is the last day of the
+	// year, in 400-year eras of 146097 days starting 0000-03-01.
+	m := int64(d.Month) - 1
+	y := int64(d.Year) + floorDiv(m, 12)
+	m = floorMod(m, 12) + 1
+	if m <= 2 {
+		y--
+		m += 12
+	}
+	era := floorDiv(y, 400)
+	yoe := y - era*400
+	doy := (153*(m-3)+2)/5 + int64(d.Day) - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+	return era*146097 + doe - 719468
+}
+
+// FromEpochDays returns the date n days after 1970-01-01, or before it for
+// negative n. It is the inverse of DaysSinceEpoch.
+func FromEpochDays(n int64) Date {
+	z := n + 719468
+	era := floorDiv(z, 146097)
+	doe := z - era*146097
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+	doy := doe - (365*yoe + yoe/4 - yoe/100)
+	mp := (5*doy + 2) / 153
+	day := doy - (153*mp+2)/5 + 1
+	month := mp + 3
+	year := yoe + era*400
+	if month > 12 {
+		month -= 12
+		year++
+	}
+	return Date{Year: int(year), Month: time.Month(month), Day: int(day)}
+}
@@ -0,0 +1,96 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEpochMillisDateTime_JSON(t *testing.T) {
+	type TC struct {
+		In  DateTime
+		Out string
+	}
+	tcs := []TC{
+		TC{In: DateTime{Date{1970, 1, 1}, Time{0, 0, 0, 0}}, Out: "0"},
+		TC{In: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876000000}}, Out: "1582947751876"},
+		TC{In: DateTime{Date{1969, 12, 31}, Time{23, 59, 59, 999000000}}, Out: "-1"},
+	}
+	for _, tc := range tcs {
+		b, err := json.Marshal(EpochMillisDateTime(tc.In))
+		assert.NoError(t, err)
+		assert.Equal(t, tc.Out, string(b))
+
+		var v EpochMillisDateTime
+		assert.NoError(t, json.Unmarshal(b, &v))
+		assert.Equal(t, tc.In, DateTime(v))
+	}
+
+	var ev struct {
+		At EpochMillisDateTime `json:"at"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"at":1582947751876}`), &ev))
+	assert.Equal(t, "2020-02-29T03:42:31.876000000", ev.At.String())
+	assert.NoError(t, json.Unmarshal([]byte(`{"at":null}`), &ev))
+	assert.Equal(t, "2020-02-29T03:42:31.876000000", ev.At.String())
+}
+
+/* === ERRORS === */
+
+func TestDate_DaysSinceEpoch(t *testing.T) {
+	type TC struct {
+		In  Date
+		Out int64
+	}
+	tcs := []TC{
+		TC{In: Date{1970, 1, 1}, Out: 0},
+		TC{In: Date{1969, 12, 31}, Out: -1},
+		TC{In: Date{2000, 3, 1}, Out: 11017},
+		TC{In: Date{2020, 2, 29}, Out: 18321},
+		TC{In: Date{1900, 3, 1}, Out: -25508},
+		TC{In: Date{0, 1, 1}, Out: -719528},
+		TC{In: Date{-1, 12, 31}, Out: -719529},
+		TC{In: Date{9999, 12, 31}, Out: 2932896},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.DaysSinceEpoch(), "%v", tc.In)
+		assert.Equal(t, tc.In, FromEpochDays(tc.Out), "%d", tc.Out)
+	}
+
+	// Days and months out of range count on, as for AddDays.
+	assert.Equal(t, Date{2020, 3, 1}.DaysSinceEpoch(), Date{2020, 2, 30}.DaysSinceEpoch())
+	assert.Equal(t, Date{2021, 1, 1}.DaysSinceEpoch(), Date{2020, 13, 1}.DaysSinceEpoch())
+	assert.Equal(t, Date{2019, 12, 1}.DaysSinceEpoch(), Date{2020, 0, 1}.DaysSinceEpoch())
+
+	d := Date{-401, 1, 1}
+	for n := d.DaysSinceEpoch(); d.Year < 2401; n++ {
+		if int64(d.DaysSince(Date{1970, 1, 1})) != n || FromEpochDays(n) != d {
+			t.Fatalf("%v: DaysSinceEpoch %d, FromEpochDays %v", d, n, FromEpochDays(n))
+		}
+		d = d.AddDays(1)
+	}
+}
+
+func TestEpochMillisDateTime_JSON_Errors(t *testing.T) {
+	for _, s := range []string{`"1582947751876"`, `1582947751876.5`, `1e12`, `true`} {
+		var v EpochMillisDateTime
+		err := v.UnmarshalJSON([]byte(s))
+		assert.True(t, errors.Is(err, ErrInvalidFormat), s)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Sentinel errors wrapped by the errors this package returns, so that callers
+// can test for a kind of failure with errors.Is.
+var (
+	// ErrInvalidFormat means a string does not have the expected format.
+	ErrInvalidFormat = errors.New("civil: invalid format")
+
+	// ErrYearOutOfRange means a year cannot be represented in the format
+	// being used, such as a year outside [0,9999] in RFC 3339.
+	ErrYearOutOfRange = errors.New("civil: year out of range")
+
+	// ErrInvalidMonth means a month is outside [1,12].
+	ErrInvalidMonth = errors.New("civil: invalid month")
+
+	// ErrInvalidDay means a day does not exist in its month.
+	ErrInvalidDay = errors.New("civil: invalid day")
+
+	// ErrInvalidTime means an hour, minute, second or nanosecond is out of
+	// range.
+	ErrInvalidTime = errors.New("civil: invalid time of day")
+
+	// ErrInvalidOffset means a UTC offset is malformed or not allowed.
+	ErrInvalidOffset = errors.New("civil: invalid UTC offset")
+
+	// ErrUnsupportedType means Scan was given a value of a type it cannot
+	// convert.
+	ErrUnsupportedType = errors.New("civil: unsupported type")
+
+	// ErrNull means Scan was given a SQL NULL for a type that cannot
+	// represent it.
+	ErrNull = errors.New("civil: NULL value")
+
+	// ErrInvalidRange means a range of dates ends before it starts.
+	ErrInvalidRange = errors.New("civil: invalid range")
+
+	// ErrInvalidUnit means a unit of time, such as a ParquetTimeUnit, is
+	// not one of the defined values.
+	ErrInvalidUnit = errors.New("civil: invalid unit")
+)
+
+// A ParseError describes a string that could not be parsed as a Date, Time or
+// DateTime. It identifies the component that was being parsed and where in
+// the input the problem was found, so that callers can report it to users.
+//
+// For the layout-based functions such as ParseDateLayout, which parse with
+// time.Parse, Offset is where time.Parse stopped. For a field that is out of
+// range that is the end of the field, and for a day that does not exist in
+// its month it is the end of the input.
+type ParseError struct {
+	Input     string    // the complete input
+	Offset    int       // byte offset of the problem in Input
+	Component Component // the component being parsed
+	Message   string    // description of the problem
+	Err       error     // the sentinel error for the kind of problem
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("civil: parsing %q: %s at offset %d", e.Input, e.Message, e.Offset)
+}
+
+// Unwrap returns e.Err, so that errors.Is(err, ErrInvalidDay) and the like
+// report the kind of problem.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Component identifies a part of a date or time string.
+type Component int
+
+// The components of a date or time string.
+const (
+	ComponentUnknown Component = iota
+	ComponentYear
+	ComponentMonth
+	ComponentDay
+	ComponentHour
+	ComponentMinute
+	ComponentSecond
+	ComponentFraction  // the fractional second
+	ComponentSeparator // the separator between the date and the time
+	ComponentOffset    // the UTC offset
+)
+
+var componentNames = [...]string{
+	ComponentUnknown:   "unknown",
+	ComponentYear:      "year",
+	ComponentMonth:     "month",
+	ComponentDay:       "day",
+	ComponentHour:      "hour",
+	ComponentMinute:    "minute",
+	ComponentSecond:    "second",
+	ComponentFraction:  "fractional second",
+	ComponentSeparator: "separator",
+	ComponentOffset:    "UTC offset",
+}
+
+func (c Component) String() string {
+	if c < 0 || int(c) >= len(componentNames) {
+		return fmt.Sprintf("Component(%d)", int(c))
+	}
+	return componentNames[c]
+}
+
+// layoutError converts an error returned by time.Parse into a *ParseError.
+func layoutError(err error) error {
+	pe, ok := err.(*time.ParseError)
+	if !ok {
+		return err
+	}
+	e := &ParseError{
+		Input:     pe.Value,
+		Offset:    len(pe.Value) - len(pe.ValueElem),
+		Component: layoutComponent(pe.LayoutElem, pe.Message),
+		Message:   strings.TrimPrefix(pe.Message, ": "),
+		Err:       ErrInvalidFormat,
+	}
+	if e.Message == "" {
+		e.Message = fmt.Sprintf("cannot parse %q as %q", pe.ValueElem, pe.LayoutElem)
+	}
+	if strings.HasSuffix(e.Message, "out of range") {
+		e.Err = componentErr(e.Component)
+	}
+	return e
+}
+
+// componentErr returns the sentinel error for a value of c that is out of
+// range.
+func componentErr(c Component) error {
+	switch c {
+	case ComponentYear:
+		return ErrYearOutOfRange
+	case ComponentMonth:
+		return ErrInvalidMonth
+	case ComponentDay:
+		return ErrInvalidDay
+	case ComponentHour, ComponentMinute, ComponentSecond, ComponentFraction:
+		return ErrInvalidTime
+	case ComponentOffset:
+		return ErrInvalidOffset
+	}
+	return ErrInvalidFormat
+}
+
+// layoutComponent returns the component parsed by the time.Parse layout
+// element elem. For errors not tied to an element, it looks for the name of
+// the component in the message.
+func layoutComponent(elem, message string) Component {
+	switch elem {
+	case "2006", "06":
+		return ComponentYear
+	case "January", "Jan", "1", "01":
+		return ComponentMonth
+	case "Monday", "Mon", "2", "_2", "02", "__2", "002":
+		return ComponentDay
+	case "15", "3", "03", "PM", "pm":
+		return ComponentHour
+	case "4", "04":
+		return ComponentMinute
+	case "5", "05":
+		return ComponentSecond
+	case "MST", "Z0700", "Z070000", "Z07", "Z07:00", "Z07:00:00",
+		"-0700", "-070000", "-07", "-07:00", "-07:00:00":
+		return ComponentOffset
+	case "":
+		for c := ComponentYear; c <= ComponentSecond; c++ {
+			if strings.HasPrefix(message, ": "+c.String()) {
+				return c
+			}
+		}
+		return ComponentUnknown
+	}
+	if len(elem) > 1 && (elem[0] == '.' || elem[0] == ',') && (elem[1] == '0' || elem[1] == '9') {
+		return ComponentFraction
+	}
+	return ComponentSeparator
+}
@@ -0,0 +1,132 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseError(t *testing.T) {
+	parseDate := func(s string) error { _, err := ParseDate(s); return err }
+	parseTime := func(s string) error { _, err := ParseTime(s); return err }
+	parseDateTime := func(s string) error { _, err := ParseDateTime(s); return err }
+	parseLayout := func(s string) error { _, err := ParseDateLayout("01/02/2006", s); return err }
+
+	type TC struct {
+		Parse     func(string) error
+		In        string
+		Component Component
+		Offset    int
+		Message   string
+	}
+	tcs := []TC{
+		TC{parseDate, "2021-02-30", ComponentDay, 8, "day 30 out of range [1,28] for February 2021"},
+		TC{parseDate, "2021-13-01", ComponentMonth, 5, "month 13 out of range [1,12]"},
+		TC{parseDate, "21-02-03", ComponentYear, 0, "expected 4 digits for year"},
+		TC{parseDate, "2021/02/03", ComponentMonth, 4, "expected '-' before month"},
+		TC{parseDate, "2021-2-03", ComponentMonth, 5, "expected 2 digits for month"},
+		TC{parseDate, "2021-02-031", ComponentDay, 10, `unexpected text "1" after day`},
+		TC{parseTime, "25:00:00", ComponentHour, 0, "hour 25 out of range [0,23]"},
+		TC{parseTime, "03:60", ComponentMinute, 3, "minute 60 out of range [0,59]"},
+		TC{parseTime, "03:42:61", ComponentSecond, 6, "second 61 out of range [0,59]"},
+		TC{parseTime, "03:42:31.", ComponentFraction, 9, "expected digits after the decimal separator"},
+		TC{parseTime, "03:42:31.0123456789", ComponentFraction, 9, "fractional second has more than nine digits"},
+		TC{parseTime, "03:42:31Z", ComponentSecond, 8, `unexpected text "Z" after second`},
+		TC{parseDateTime, "2021-02-03", ComponentSeparator, 10, "expected 'T' between date and time"},
+		TC{parseDateTime, "2021-02-03T03:4", ComponentMinute, 14, "expected 2 digits for minute"},
+		TC{parseDateTime, "2021-02-03T03:42:31Z", ComponentOffset, 19, `unexpected UTC offset "Z"`},
+		TC{parseLayout, "02/30/2021", ComponentDay, 10, "day out of range"},
+		TC{parseLayout, "13/03/2021", ComponentMonth, 2, "month out of range"},
+		TC{parseLayout, "02-03-2021", ComponentSeparator, 2, `cannot parse "-03-2021" as "/"`},
+	}
+	for _, tc := range tcs {
+		var pe *ParseError
+		if assert.True(t, errors.As(tc.Parse(tc.In), &pe), tc.In) {
+			assert.Equal(t, tc.In, pe.Input, tc.In)
+			assert.Equal(t, tc.Component, pe.Component, tc.In)
+			assert.Equal(t, tc.Offset, pe.Offset, tc.In)
+			assert.Equal(t, tc.Message, pe.Message, tc.In)
+		}
+	}
+
+	_, err := ParseOptions{SpaceSeparator: true}.ParseDateTime("2021-02-03_03:42")
+	assert.EqualError(t, err, `civil: parsing "2021-02-03_03:42": expected 'T' or ' ' between date and time at offset 10`)
+	_, err = ParseOptions{Offset: OffsetStrip}.ParseDateTime("2021-02-03T03:42+25:00")
+	assert.EqualError(t, err, `civil: parsing "2021-02-03T03:42+25:00": invalid UTC offset "+25:00" at offset 16`)
+}
+
+func TestParseError_Unmarshal(t *testing.T) {
+	var pe *ParseError
+
+	var d Date
+	assert.True(t, errors.As(d.UnmarshalJSON([]byte(`"2021-02-30"`)), &pe))
+	assert.Equal(t, ComponentDay, pe.Component)
+
+	var tm Time
+	assert.True(t, errors.As(tm.UnmarshalJSON([]byte(`"03:42:61"`)), &pe))
+	assert.Equal(t, ComponentSecond, pe.Component)
+
+	var dt DateTime
+	assert.True(t, errors.As(dt.UnmarshalJSON([]byte(`"2021-02-03T25:00:00"`)), &pe))
+	assert.Equal(t, ComponentHour, pe.Component)
+	assert.Equal(t, 11, pe.Offset)
+}
+
+func TestSentinelErrors(t *testing.T) {
+	type TC struct {
+		Err  error
+		Want error
+	}
+	_, errDay := ParseDate("2021-02-30")
+	_, errMonth := ParseDate("2021-13-01")
+	_, errFormat := ParseDate("2021/02/03")
+	_, errTime := ParseTime("03:60:00")
+	_, errOffset := ParseDateTime("2021-02-03T03:42Z")
+	_, errLayout := ParseDateLayout("01/02/2006", "02/30/2021")
+	_, errWindow := ParseOptions{TwoDigitYearStart: 1950}.ParseDateLayout("01/02/06", "02/29/01")
+	tcs := []TC{
+		TC{Err: errDay, Want: ErrInvalidDay},
+		TC{Err: errMonth, Want: ErrInvalidMonth},
+		TC{Err: errFormat, Want: ErrInvalidFormat},
+		TC{Err: errTime, Want: ErrInvalidTime},
+		TC{Err: errOffset, Want: ErrInvalidOffset},
+		TC{Err: errLayout, Want: ErrInvalidDay},
+		TC{Err: errWindow, Want: ErrInvalidDay},
+		TC{Err: (&Date{}).UnmarshalJSON([]byte(`"2021-02-30"`)), Want: ErrInvalidDay},
+		TC{Err: (&Time{}).UnmarshalText([]byte("24:00")), Want: ErrInvalidTime},
+		TC{Err: (&DateTime{}).Scan("2021-13-01T00:00"), Want: ErrInvalidMonth},
+		TC{Err: (&Date{}).Scan(42), Want: ErrUnsupportedType},
+		TC{Err: (&Time{}).Scan(42), Want: ErrUnsupportedType},
+		TC{Err: (&DateTime{}).Scan([]int{}), Want: ErrUnsupportedType},
+		TC{Err: (&Date{}).Scan(nil), Want: ErrNull},
+		TC{Err: (&Date{}).UnmarshalJSON([]byte(`20210203`)), Want: ErrInvalidFormat},
+		TC{Err: (&Time{}).UnmarshalJSON([]byte(`{}`)), Want: ErrInvalidFormat},
+		TC{Err: (&DateTime{}).UnmarshalJSON([]byte(`true`)), Want: ErrInvalidFormat},
+	}
+	for i, tc := range tcs {
+		assert.True(t, errors.Is(tc.Err, tc.Want), "case %d: %v", i, tc.Err)
+	}
+
+	err := (&DateTime{}).Scan(42)
+	assert.EqualError(t, err, "civil: unsupported type: cannot scan int into DateTime; want string, []byte, time.Time, *time.Time or sql.NullTime")
+}
+
+func TestComponent_String(t *testing.T) {
+	assert.Equal(t, "fractional second", ComponentFraction.String())
+	assert.Equal(t, "Component(42)", Component(42).String())
+}
@@ -0,0 +1,98 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ExcelEpoch selects which of the two date systems of spreadsheet serial
+// dates is used. A serial date is the number of days since the epoch, with
+// the time of day as the fraction of the day.
+type ExcelEpoch int
+
+const (
+	// Excel1900 is the default date system of Excel, in which serial 1 is
+	// 1900-01-01. Following Lotus 1-2-3, it treats 1900 as a leap year, so
+	// serial 60 is the nonexistent 1900-02-29 and every later serial is one
+	// more than the days since 1899-12-31. Google Sheets and LibreOffice
+	// agree with it from serial 61, 1900-03-01, onwards.
+	Excel1900 ExcelEpoch = iota
+
+	// Excel1904 is the date system of workbooks made with early Mac
+	// versions of Excel, in which serial 0 is 1904-01-01.
+	Excel1904
+)
+
+// Serials in the 1900 date system count from excel1900Epoch on and after
+// excelLeapBug, and from the day after it before.
+var (
+	excel1900Epoch = Date{Year: 1899, Month: time.December, Day: 30}
+	excel1904Epoch = Date{Year: 1904, Month: time.January, Day: 1}
+	excelLeapBug   = Date{Year: 1900, Month: time.March, Day: 1}
+)
+
+// FromExcelSerial returns the datetime of a spreadsheet serial date in the
+// date system of epoch, rounded to the millisecond, the finest precision
+// that Excel displays and about the limit of a float64 serial. It returns an
+// error wrapping ErrInvalidFormat if serial is negative or not finite, or
+// falls on day 60 in the 1900 date system, and an error wrapping
+// ErrYearOutOfRange if the year is outside [MinYear,MaxYear].
+func FromExcelSerial(serial float64, epoch ExcelEpoch) (DateTime, error) {
+	if math.IsNaN(serial) || serial < 0 || serial > 1<<32 {
+		return DateTime{}, fmt.Errorf("%w: %v is not a spreadsheet serial date", ErrInvalidFormat, serial)
+	}
+	ms := int64(math.Round(serial * float64(dayMillis)))
+	dt := dateTimeFromLocalTimestamp(ms, time.Millisecond)
+	days := dt.Date.DaysSinceEpoch()
+
+	start := excel1904Epoch
+	if epoch == Excel1900 {
+		switch {
+		case days < 60:
+			start = excel1900Epoch.AddDays(1)
+		case days == 60:
+			return DateTime{}, fmt.Errorf("%w: serial %v is in 1900-02-29, which does not exist", ErrInvalidFormat, serial)
+		default:
+			start = excel1900Epoch
+		}
+	}
+	dt.Date = FromEpochDays(start.DaysSinceEpoch() + days)
+	if err := checkYear(dt.Date.Year); err != nil {
+		return DateTime{}, err
+	}
+	return dt, nil
+}
+
+// ToExcelSerial returns dt as a spreadsheet serial date in the date system
+// of epoch. It returns an error wrapping ErrYearOutOfRange if dt is before
+// day 0 of the date system, which is 1899-12-31 in the 1900 date system,
+// where it holds times of day alone, and 1904-01-01 in the 1904 system.
+func (dt DateTime) ToExcelSerial(epoch ExcelEpoch) (float64, error) {
+	start := excel1904Epoch
+	if epoch == Excel1900 {
+		start = excel1900Epoch
+		if dt.Date.Before(excelLeapBug) {
+			start = start.AddDays(1)
+		}
+	}
+	days := dt.Date.DaysSince(start)
+	if days < 0 {
+		return 0, fmt.Errorf("%w: %v is before the first spreadsheet serial date", ErrYearOutOfRange, dt)
+	}
+	return float64(days) + float64(dt.Time.sinceMidnight())/float64(24*time.Hour), nil
+}
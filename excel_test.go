@@ -0,0 +1,75 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExcelSerial_RoundTrip(t *testing.T) {
+	type TC struct {
+		In     DateTime
+		Epoch  ExcelEpoch
+		Serial float64
+	}
+	tcs := []TC{
+		TC{In: DateTime{Date{1899, 12, 31}, Time{12, 0, 0, 0}}, Epoch: Excel1900, Serial: 0.5},
+		TC{In: DateTime{Date{1900, 1, 1}, Time{}}, Epoch: Excel1900, Serial: 1},
+		TC{In: DateTime{Date{1900, 2, 28}, Time{}}, Epoch: Excel1900, Serial: 59},
+		TC{In: DateTime{Date{1900, 3, 1}, Time{}}, Epoch: Excel1900, Serial: 61},
+		TC{In: DateTime{Date{2020, 2, 29}, Time{6, 0, 0, 0}}, Epoch: Excel1900, Serial: 43890.25},
+		TC{In: DateTime{Date{9999, 12, 31}, Time{}}, Epoch: Excel1900, Serial: 2958465},
+		TC{In: DateTime{Date{1904, 1, 1}, Time{}}, Epoch: Excel1904, Serial: 0},
+		TC{In: DateTime{Date{2020, 2, 29}, Time{6, 0, 0, 0}}, Epoch: Excel1904, Serial: 42428.25},
+	}
+	for _, tc := range tcs {
+		serial, err := tc.In.ToExcelSerial(tc.Epoch)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.Serial, serial, "%v", tc.In)
+
+		dt, err := FromExcelSerial(tc.Serial, tc.Epoch)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.In, dt, "%v", tc.Serial)
+	}
+}
+
+func TestFromExcelSerial_Rounding(t *testing.T) {
+	// 12:34:56 is stored as an inexact binary fraction.
+	dt, err := FromExcelSerial(43890.524259259259, Excel1900)
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{12, 34, 56, 0}}, dt)
+}
+
+/* === ERRORS === */
+
+func TestExcelSerial_Errors(t *testing.T) {
+	for _, serial := range []float64{-1, math.NaN(), math.Inf(1), 60, 60.5} {
+		_, err := FromExcelSerial(serial, Excel1900)
+		assert.True(t, errors.Is(err, ErrInvalidFormat), "%v", serial)
+	}
+	_, err := FromExcelSerial(-0.5, Excel1904)
+	assert.True(t, errors.Is(err, ErrInvalidFormat))
+	_, err = FromExcelSerial(2958466, Excel1900)
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+
+	_, err = DateTime{Date{1899, 12, 30}, Time{}}.ToExcelSerial(Excel1900)
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+	_, err = DateTime{Date{1903, 12, 31}, Time{}}.ToExcelSerial(Excel1904)
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+}
@@ -0,0 +1,150 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// The Firestore helpers convert civil values to and from the values that
+// cloud.google.com/go/firestore stores, since its reflection-based encoding
+// has no hook for custom types. Left to itself it writes a civil value as a
+// map of its fields, and it cannot read a string or timestamp back into
+// one. Convert the values at the edge instead:
+//
+//	_, err := doc.Set(ctx, map[string]interface{}{
+//		"hired":   employee.Hired.FirestoreValue(),
+//		"updated": employee.Updated.FirestoreValue(),
+//	})
+//
+//	hired, err := DateFromFirestore(snap.Data()["hired"])
+//
+// A Date or Time is stored as a string and a DateTime as a timestamp in
+// UTC, which Firestore keeps to the microsecond. The From functions also
+// read the maps of fields written by the reflection-based encoding, so
+// existing documents remain readable.
+
+// FirestoreValue returns the date as a "2006-01-02" string, which sorts in
+// date order.
+func (d Date) FirestoreValue() string {
+	return d.String()
+}
+
+// DateFromFirestore returns the date of a Firestore value: a string parsed
+// as by UnmarshalText, a timestamp, whose date in UTC is taken, or a map of
+// the Date fields. A nil value is an error wrapping ErrNull.
+func DateFromFirestore(v interface{}) (Date, error) {
+	switch v := v.(type) {
+	case nil:
+		return Date{}, fmt.Errorf("%w: cannot convert a Firestore null into Date", ErrNull)
+	case string:
+		return decodeOptions().ParseDate(v)
+	case time.Time:
+		return DateOf(v.UTC()), nil
+	case map[string]interface{}:
+		return dateFromFirestoreMap(v)
+	}
+	return Date{}, fmt.Errorf("%w: cannot convert Firestore %T into Date", ErrUnsupportedType, v)
+}
+
+// FirestoreValue returns the time as a string in the format of String.
+func (t Time) FirestoreValue() string {
+	return t.String()
+}
+
+// TimeFromFirestore returns the time of a Firestore value: a string parsed
+// as by UnmarshalText, a timestamp, whose time of day in UTC is taken, or a
+// map of the Time fields. A nil value is an error wrapping ErrNull.
+func TimeFromFirestore(v interface{}) (Time, error) {
+	switch v := v.(type) {
+	case nil:
+		return Time{}, fmt.Errorf("%w: cannot convert a Firestore null into Time", ErrNull)
+	case string:
+		return decodeOptions().ParseTime(v)
+	case time.Time:
+		return TimeOf(v.UTC()), nil
+	case map[string]interface{}:
+		return timeFromFirestoreMap(v)
+	}
+	return Time{}, fmt.Errorf("%w: cannot convert Firestore %T into Time", ErrUnsupportedType, v)
+}
+
+// FirestoreValue returns the datetime as a time.Time in UTC, which
+// Firestore stores as a timestamp.
+func (dt DateTime) FirestoreValue() time.Time {
+	return dt.In(time.UTC)
+}
+
+// DateTimeFromFirestore returns the datetime of a Firestore value: a
+// timestamp, read in UTC, a string parsed as by UnmarshalText, or a map of
+// the DateTime fields. A nil value is an error wrapping ErrNull.
+func DateTimeFromFirestore(v interface{}) (DateTime, error) {
+	switch v := v.(type) {
+	case nil:
+		return DateTime{}, fmt.Errorf("%w: cannot convert a Firestore null into DateTime", ErrNull)
+	case time.Time:
+		return DateTimeOf(v.UTC()), nil
+	case string:
+		return decodeOptions().ParseDateTime(v)
+	case map[string]interface{}:
+		d, err := DateFromFirestore(v["Date"])
+		if err != nil {
+			return DateTime{}, err
+		}
+		t, err := TimeFromFirestore(v["Time"])
+		if err != nil {
+			return DateTime{}, err
+		}
+		return DateTime{Date: d, Time: t}, nil
+	}
+	return DateTime{}, fmt.Errorf("%w: cannot convert Firestore %T into DateTime", ErrUnsupportedType, v)
+}
+
+// dateFromFirestoreMap reads a Date written by Firestore's reflection-based
+// encoding.
+func dateFromFirestoreMap(m map[string]interface{}) (Date, error) {
+	var f [3]int
+	for i, name := range []string{"Year", "Month", "Day"} {
+		var err error
+		if f[i], err = firestoreInt(m, name, "Date"); err != nil {
+			return Date{}, err
+		}
+	}
+	return NewDate(f[0], time.Month(f[1]), f[2])
+}
+
+// timeFromFirestoreMap reads a Time written by Firestore's reflection-based
+// encoding.
+func timeFromFirestoreMap(m map[string]interface{}) (Time, error) {
+	var f [4]int
+	for i, name := range []string{"Hour", "Minute", "Second", "Nanosecond"} {
+		var err error
+		if f[i], err = firestoreInt(m, name, "Time"); err != nil {
+			return Time{}, err
+		}
+	}
+	return NewTime(f[0], f[1], f[2], f[3])
+}
+
+// firestoreInt returns the integer field name of m, which Firestore reads
+// as an int64.
+func firestoreInt(m map[string]interface{}, name, typeName string) (int, error) {
+	v, ok := m[name].(int64)
+	if !ok {
+		return 0, fmt.Errorf("%w: Firestore map has no integer %s for %s", ErrInvalidFormat, name, typeName)
+	}
+	return int(v), nil
+}
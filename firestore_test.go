@@ -0,0 +1,99 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirestore_RoundTrip(t *testing.T) {
+	d := Date{2020, 2, 29}
+	assert.Equal(t, "2020-02-29", d.FirestoreValue())
+	d0, err := DateFromFirestore(d.FirestoreValue())
+	assert.NoError(t, err)
+	assert.Equal(t, d, d0)
+
+	tm := Time{3, 42, 31, 876}
+	assert.Equal(t, "03:42:31.000000876", tm.FirestoreValue())
+	tm0, err := TimeFromFirestore(tm.FirestoreValue())
+	assert.NoError(t, err)
+	assert.Equal(t, tm, tm0)
+
+	dt := DateTime{d, Time{3, 42, 31, 876000}}
+	assert.Equal(t, time.Date(2020, 2, 29, 3, 42, 31, 876000, time.UTC), dt.FirestoreValue())
+	dt0, err := DateTimeFromFirestore(dt.FirestoreValue())
+	assert.NoError(t, err)
+	assert.Equal(t, dt, dt0)
+}
+
+func TestFirestore_From(t *testing.T) {
+	ts := time.Date(2020, 2, 29, 3, 42, 31, 0, time.FixedZone("", 5*60*60))
+	legacy := map[string]interface{}{
+		"Date": map[string]interface{}{"Year": int64(2020), "Month": int64(2), "Day": int64(29)},
+		"Time": map[string]interface{}{"Hour": int64(3), "Minute": int64(42), "Second": int64(31), "Nanosecond": int64(876)},
+	}
+
+	d, err := DateFromFirestore(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, Date{2020, 2, 28}, d)
+	d, err = DateFromFirestore(legacy["Date"])
+	assert.NoError(t, err)
+	assert.Equal(t, Date{2020, 2, 29}, d)
+
+	tm, err := TimeFromFirestore(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, Time{22, 42, 31, 0}, tm)
+	tm, err = TimeFromFirestore(legacy["Time"])
+	assert.NoError(t, err)
+	assert.Equal(t, Time{3, 42, 31, 876}, tm)
+
+	dt, err := DateTimeFromFirestore("2020-02-29T03:42:31")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}, dt)
+	dt, err = DateTimeFromFirestore(legacy)
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876}}, dt)
+}
+
+/* === ERRORS === */
+
+func TestFirestore_Errors(t *testing.T) {
+	_, err := DateFromFirestore(nil)
+	assert.True(t, errors.Is(err, ErrNull))
+	_, err = DateFromFirestore(int64(18321))
+	assert.EqualError(t, err, "civil: unsupported type: cannot convert Firestore int64 into Date")
+	_, err = DateFromFirestore(map[string]interface{}{"Year": int64(2021), "Month": int64(2), "Day": int64(29)})
+	assert.True(t, errors.Is(err, ErrInvalidDay))
+	_, err = DateFromFirestore(map[string]interface{}{"Year": "2020", "Month": int64(2), "Day": int64(29)})
+	assert.EqualError(t, err, "civil: invalid format: Firestore map has no integer Year for Date")
+
+	_, err = TimeFromFirestore(nil)
+	assert.True(t, errors.Is(err, ErrNull))
+	_, err = TimeFromFirestore(true)
+	assert.True(t, errors.Is(err, ErrUnsupportedType))
+	_, err = TimeFromFirestore(map[string]interface{}{"Hour": int64(25)})
+	assert.True(t, errors.Is(err, ErrInvalidFormat))
+
+	_, err = DateTimeFromFirestore(nil)
+	assert.True(t, errors.Is(err, ErrNull))
+	_, err = DateTimeFromFirestore(1.5)
+	assert.True(t, errors.Is(err, ErrUnsupportedType))
+	_, err = DateTimeFromFirestore(map[string]interface{}{"Date": "2020-02-29"})
+	assert.True(t, errors.Is(err, ErrNull))
+}
@@ -0,0 +1,136 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import "time"
+
+// A FiscalCalendar divides time into fiscal years of twelve months starting
+// on the first day of StartMonth, each made up of four quarters and twelve
+// periods of one calendar month. The zero FiscalCalendar starts in January
+// and so matches the calendar year. A StartMonth of zero is the only value
+// outside January to December that means January: build calendars from
+// configuration with NewFiscalCalendar, or check them with Validate, since
+// the other methods do not report an invalid StartMonth.
+//
+// Fiscal years are numbered by the calendar year in which they end, so with
+// a StartMonth of October fiscal year 2025 runs from 2024-10-01 to
+// 2025-09-30, as for the US federal government. Set NameByStartYear to
+// number them by the year in which they start instead, so with a
+// StartMonth of April fiscal year 2024 runs from 2024-04-01 to 2025-03-31,
+// as in India and Japan.
+type FiscalCalendar struct {
+	StartMonth      time.Month
+	NameByStartYear bool
+}
+
+// NewFiscalCalendar returns the FiscalCalendar with the given StartMonth
+// and NameByStartYear. It returns an error wrapping ErrInvalidMonth if
+// startMonth is outside [1,12].
+func NewFiscalCalendar(startMonth time.Month, nameByStartYear bool) (FiscalCalendar, error) {
+	if startMonth < time.January || startMonth > time.December {
+		return FiscalCalendar{}, &FieldError{ComponentMonth, int(startMonth), 1, 12, ErrInvalidMonth}
+	}
+	return FiscalCalendar{StartMonth: startMonth, NameByStartYear: nameByStartYear}, nil
+}
+
+// Validate returns nil if c.StartMonth is zero or in [1,12], and otherwise
+// a *FieldError wrapping ErrInvalidMonth.
+func (c FiscalCalendar) Validate() error {
+	if c.StartMonth < 0 || c.StartMonth > time.December {
+		return &FieldError{ComponentMonth, int(c.StartMonth), 1, 12, ErrInvalidMonth}
+	}
+	return nil
+}
+
+// startMonth returns the first month of the fiscal year, treating the zero
+// value, and any other invalid month, as January.
+func (c FiscalCalendar) startMonth() time.Month {
+	if c.StartMonth < time.January || c.StartMonth > time.December {
+		return time.January
+	}
+	return c.StartMonth
+}
+
+// firstYear returns the calendar year in which fiscal year fy starts.
+func (c FiscalCalendar) firstYear(fy int) int {
+	if c.NameByStartYear || c.startMonth() == time.January {
+		return fy
+	}
+	return fy - 1
+}
+
+// FiscalYear returns the fiscal year containing d.
+func (c FiscalCalendar) FiscalYear(d Date) int {
+	year, _ := c.FiscalPeriod(d)
+	return year
+}
+
+// FiscalQuarter returns the fiscal year and the quarter of it, from 1 to 4,
+// containing d.
+func (c FiscalCalendar) FiscalQuarter(d Date) (year, quarter int) {
+	year, period := c.FiscalPeriod(d)
+	return year, (period-1)/3 + 1
+}
+
+// FiscalPeriod returns the fiscal year and the period of it, from 1 to 12,
+// containing d. Each period is one calendar month, so period 1 is
+// StartMonth.
+func (c FiscalCalendar) FiscalPeriod(d Date) (year, period int) {
+	start := c.startMonth()
+	year = d.Year
+	if d.Month < start {
+		year--
+	}
+	if !c.NameByStartYear && start != time.January {
+		year++
+	}
+	return year, (int(d.Month)-int(start)+12)%12 + 1
+}
+
+// YearStart returns the first day of fiscal year fy.
+func (c FiscalCalendar) YearStart(fy int) Date {
+	return c.PeriodStart(fy, 1)
+}
+
+// YearEnd returns the last day of fiscal year fy.
+func (c FiscalCalendar) YearEnd(fy int) Date {
+	return c.PeriodEnd(fy, 12)
+}
+
+// QuarterStart returns the first day of quarter q, from 1 to 4, of fiscal
+// year fy.
+func (c FiscalCalendar) QuarterStart(fy, q int) Date {
+	return c.PeriodStart(fy, (q-1)*3+1)
+}
+
+// QuarterEnd returns the last day of quarter q, from 1 to 4, of fiscal year
+// fy.
+func (c FiscalCalendar) QuarterEnd(fy, q int) Date {
+	return c.PeriodEnd(fy, q*3)
+}
+
+// PeriodStart returns the first day of period p, from 1 to 12, of fiscal
+// year fy. Periods outside that range count on into the neighbouring
+// fiscal years.
+func (c FiscalCalendar) PeriodStart(fy, p int) Date {
+	first := Date{Year: c.firstYear(fy), Month: c.startMonth(), Day: 1}
+	return first.AddMonths(p - 1)
+}
+
+// PeriodEnd returns the last day of period p, from 1 to 12, of fiscal year
+// fy.
+func (c FiscalCalendar) PeriodEnd(fy, p int) Date {
+	return c.PeriodStart(fy, p).EndOfMonth()
+}
@@ -0,0 +1,101 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFiscalCalendar(t *testing.T) {
+	type TC struct {
+		Calendar FiscalCalendar
+		In       Date
+		Year     int
+		Quarter  int
+		Period   int
+	}
+	us := FiscalCalendar{StartMonth: time.October}
+	india := FiscalCalendar{StartMonth: time.April, NameByStartYear: true}
+	tcs := []TC{
+		TC{Calendar: FiscalCalendar{}, In: Date{2020, 2, 29}, Year: 2020, Quarter: 1, Period: 2},
+		TC{Calendar: FiscalCalendar{}, In: Date{2020, 12, 31}, Year: 2020, Quarter: 4, Period: 12},
+		TC{Calendar: us, In: Date{2024, 9, 30}, Year: 2024, Quarter: 4, Period: 12},
+		TC{Calendar: us, In: Date{2024, 10, 1}, Year: 2025, Quarter: 1, Period: 1},
+		TC{Calendar: us, In: Date{2025, 2, 14}, Year: 2025, Quarter: 2, Period: 5},
+		TC{Calendar: india, In: Date{2024, 4, 1}, Year: 2024, Quarter: 1, Period: 1},
+		TC{Calendar: india, In: Date{2025, 3, 31}, Year: 2024, Quarter: 4, Period: 12},
+		TC{Calendar: india, In: Date{2025, 1, 15}, Year: 2024, Quarter: 4, Period: 10},
+		TC{Calendar: FiscalCalendar{StartMonth: time.April}, In: Date{2025, 1, 15}, Year: 2025, Quarter: 4, Period: 10},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Year, tc.Calendar.FiscalYear(tc.In), "%v in %+v", tc.In, tc.Calendar)
+		year, quarter := tc.Calendar.FiscalQuarter(tc.In)
+		assert.Equal(t, tc.Year, year, "%v in %+v", tc.In, tc.Calendar)
+		assert.Equal(t, tc.Quarter, quarter, "%v in %+v", tc.In, tc.Calendar)
+		year, period := tc.Calendar.FiscalPeriod(tc.In)
+		assert.Equal(t, tc.Year, year, "%v in %+v", tc.In, tc.Calendar)
+		assert.Equal(t, tc.Period, period, "%v in %+v", tc.In, tc.Calendar)
+
+		assert.False(t, tc.In.Before(tc.Calendar.PeriodStart(year, period)), "%v in %+v", tc.In, tc.Calendar)
+		assert.False(t, tc.In.After(tc.Calendar.PeriodEnd(year, period)), "%v in %+v", tc.In, tc.Calendar)
+		assert.False(t, tc.In.Before(tc.Calendar.QuarterStart(year, quarter)), "%v in %+v", tc.In, tc.Calendar)
+		assert.False(t, tc.In.After(tc.Calendar.QuarterEnd(year, quarter)), "%v in %+v", tc.In, tc.Calendar)
+	}
+}
+
+func TestFiscalCalendar_Bounds(t *testing.T) {
+	us := FiscalCalendar{StartMonth: time.October}
+	assert.Equal(t, Date{2024, 10, 1}, us.YearStart(2025))
+	assert.Equal(t, Date{2025, 9, 30}, us.YearEnd(2025))
+	assert.Equal(t, Date{2025, 1, 1}, us.QuarterStart(2025, 2))
+	assert.Equal(t, Date{2025, 3, 31}, us.QuarterEnd(2025, 2))
+	assert.Equal(t, Date{2025, 2, 1}, us.PeriodStart(2025, 5))
+	assert.Equal(t, Date{2025, 2, 28}, us.PeriodEnd(2025, 5))
+
+	india := FiscalCalendar{StartMonth: time.April, NameByStartYear: true}
+	assert.Equal(t, Date{2024, 4, 1}, india.YearStart(2024))
+	assert.Equal(t, Date{2025, 3, 31}, india.YearEnd(2024))
+	assert.Equal(t, Date{2024, 2, 29}, india.PeriodEnd(2023, 11))
+
+	var calendar FiscalCalendar
+	assert.Equal(t, Date{2020, 1, 1}, calendar.YearStart(2020))
+	assert.Equal(t, Date{2020, 12, 31}, calendar.YearEnd(2020))
+}
+
+func TestNewFiscalCalendar(t *testing.T) {
+	c, err := NewFiscalCalendar(time.October, false)
+	assert.NoError(t, err)
+	assert.Equal(t, FiscalCalendar{StartMonth: time.October}, c)
+	assert.NoError(t, c.Validate())
+	assert.NoError(t, FiscalCalendar{}.Validate())
+}
+
+/* === ERRORS === */
+
+func TestFiscalCalendar_Errors(t *testing.T) {
+	for _, m := range []time.Month{0, 13, -1} {
+		_, err := NewFiscalCalendar(m, true)
+		assert.True(t, errors.Is(err, ErrInvalidMonth), "%d", m)
+	}
+	_, err := NewFiscalCalendar(13, false)
+	assert.EqualError(t, err, "civil: invalid month: month 13 is not in [1,12]")
+
+	assert.True(t, errors.Is(FiscalCalendar{StartMonth: 13}.Validate(), ErrInvalidMonth))
+	assert.True(t, errors.Is(FiscalCalendar{StartMonth: -1}.Validate(), ErrInvalidMonth))
+}
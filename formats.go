@@ -0,0 +1,315 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Layouts of the common regional date formats, for use with Format and
+// ParseDateLayout.
+const (
+	USDate = "01/02/2006" // month first, as in "02/29/2020"
+	EUDate = "02.01.2006" // day first, as in "29.02.2020"
+)
+
+// Layouts of the common regional datetime formats, for use with Format and
+// ParseDateTimeLayout. The fraction of a second is written only if it is
+// not zero.
+const (
+	USDateTime = USDate + " 15:04:05.999999999" // as in "02/29/2020 03:42:31"
+	EUDateTime = EUDate + " 15:04:05.999999999" // as in "29.02.2020 03:42:31"
+)
+
+// SQLDateTime is the layout of SQL datetime literals, as in
+// "2020-02-29 03:42:31", for use with Format and ParseDateTimeLayout.
+const SQLDateTime = RFC3339Date + " " + RFC3339Time
+
+// DateUS is a Date whose text and JSON form is "MM/DD/YYYY". Struct fields
+// pick a wire format by their type, and convert with DateUS(d) and Date(v).
+type DateUS Date
+
+// DateEU is a Date whose text and JSON form is "DD.MM.YYYY".
+type DateEU Date
+
+// DateCompact is a Date whose text and JSON form is the ISO 8601 basic
+// format "YYYYMMDD".
+type DateCompact Date
+
+// DateTimeUS is a DateTime whose text and JSON form is
+// "MM/DD/YYYY hh:mm:ss", with a fraction of a second if it is not zero.
+// Convert with DateTimeUS(dt) and DateTime(v).
+type DateTimeUS DateTime
+
+// DateTimeEU is a DateTime whose text and JSON form is
+// "DD.MM.YYYY hh:mm:ss", with a fraction of a second if it is not zero.
+type DateTimeEU DateTime
+
+// DateTimeCompact is a DateTime whose text and JSON form is the ISO 8601
+// basic format "YYYYMMDDThhmmss", with a fraction of a second if it is not
+// zero.
+type DateTimeCompact DateTime
+
+// DateTimeSQL is a DateTime whose text and JSON form is the SQL literal
+// "YYYY-MM-DD hh:mm:ss", with a fraction of a second if it is not zero, as
+// written by MySQL and many logging pipelines. It also reads the RFC 3339
+// form that DateTime reads.
+type DateTimeSQL DateTime
+
+// String returns the date in the format "MM/DD/YYYY".
+func (v DateUS) String() string {
+	return Date(v).Format(USDate)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v DateUS) MarshalText() ([]byte, error) {
+	return Date(v).AppendFormat(nil, USDate), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *DateUS) UnmarshalText(data []byte) error {
+	return (*Date)(v).unmarshalLayout(USDate, string(data))
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v DateUS) MarshalJSON() ([]byte, error) {
+	return Date(v).marshalJSONLayout(USDate), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *DateUS) UnmarshalJSON(data []byte) error {
+	return (*Date)(v).unmarshalJSONLayout(USDate, data)
+}
+
+// String returns the date in the format "DD.MM.YYYY".
+func (v DateEU) String() string {
+	return Date(v).Format(EUDate)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v DateEU) MarshalText() ([]byte, error) {
+	return Date(v).AppendFormat(nil, EUDate), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *DateEU) UnmarshalText(data []byte) error {
+	return (*Date)(v).unmarshalLayout(EUDate, string(data))
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v DateEU) MarshalJSON() ([]byte, error) {
+	return Date(v).marshalJSONLayout(EUDate), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *DateEU) UnmarshalJSON(data []byte) error {
+	return (*Date)(v).unmarshalJSONLayout(EUDate, data)
+}
+
+// String returns the date in the format "YYYYMMDD".
+func (v DateCompact) String() string {
+	return Date(v).Format(ISO8601BasicDate)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v DateCompact) MarshalText() ([]byte, error) {
+	return Date(v).AppendFormat(nil, ISO8601BasicDate), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *DateCompact) UnmarshalText(data []byte) error {
+	return (*Date)(v).unmarshalLayout(ISO8601BasicDate, string(data))
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v DateCompact) MarshalJSON() ([]byte, error) {
+	return Date(v).marshalJSONLayout(ISO8601BasicDate), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *DateCompact) UnmarshalJSON(data []byte) error {
+	return (*Date)(v).unmarshalJSONLayout(ISO8601BasicDate, data)
+}
+
+func (d Date) marshalJSONLayout(layout string) []byte {
+	b := append(make([]byte, 0, len(layout)+2), '"')
+	b = d.AppendFormat(b, layout)
+	return append(b, '"')
+}
+
+func (d *Date) unmarshalLayout(layout, s string) error {
+	val, err := ParseDateLayout(layout, s)
+	if err != nil {
+		return err
+	}
+	*d = val
+	return nil
+}
+
+func (d *Date) unmarshalJSONLayout(layout string, data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	s, err := jsonString(data, "date")
+	if err != nil {
+		return err
+	}
+	return d.unmarshalLayout(layout, s)
+}
+
+// String returns the datetime in the format "MM/DD/YYYY hh:mm:ss".
+func (v DateTimeUS) String() string {
+	return DateTime(v).Format(USDateTime)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v DateTimeUS) MarshalText() ([]byte, error) {
+	return DateTime(v).AppendFormat(nil, USDateTime), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *DateTimeUS) UnmarshalText(data []byte) error {
+	return (*DateTime)(v).unmarshalLayout(USDateTime, string(data))
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v DateTimeUS) MarshalJSON() ([]byte, error) {
+	return DateTime(v).marshalJSONLayout(USDateTime), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *DateTimeUS) UnmarshalJSON(data []byte) error {
+	return (*DateTime)(v).unmarshalJSONLayout(USDateTime, data)
+}
+
+// String returns the datetime in the format "DD.MM.YYYY hh:mm:ss".
+func (v DateTimeEU) String() string {
+	return DateTime(v).Format(EUDateTime)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v DateTimeEU) MarshalText() ([]byte, error) {
+	return DateTime(v).AppendFormat(nil, EUDateTime), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *DateTimeEU) UnmarshalText(data []byte) error {
+	return (*DateTime)(v).unmarshalLayout(EUDateTime, string(data))
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v DateTimeEU) MarshalJSON() ([]byte, error) {
+	return DateTime(v).marshalJSONLayout(EUDateTime), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *DateTimeEU) UnmarshalJSON(data []byte) error {
+	return (*DateTime)(v).unmarshalJSONLayout(EUDateTime, data)
+}
+
+// String returns the datetime in the format "YYYYMMDDThhmmss".
+func (v DateTimeCompact) String() string {
+	return DateTime(v).Format(ISO8601BasicDateTime)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v DateTimeCompact) MarshalText() ([]byte, error) {
+	return DateTime(v).AppendFormat(nil, ISO8601BasicDateTime), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *DateTimeCompact) UnmarshalText(data []byte) error {
+	return (*DateTime)(v).unmarshalLayout(ISO8601BasicDateTime, string(data))
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v DateTimeCompact) MarshalJSON() ([]byte, error) {
+	return DateTime(v).marshalJSONLayout(ISO8601BasicDateTime), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *DateTimeCompact) UnmarshalJSON(data []byte) error {
+	return (*DateTime)(v).unmarshalJSONLayout(ISO8601BasicDateTime, data)
+}
+
+// String returns the datetime in the format "YYYY-MM-DD hh:mm:ss".
+func (v DateTimeSQL) String() string {
+	return DateTime(v).Format(SQLDateTime)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v DateTimeSQL) MarshalText() ([]byte, error) {
+	return DateTime(v).AppendFormat(nil, SQLDateTime), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *DateTimeSQL) UnmarshalText(data []byte) error {
+	return (*DateTime)(v).unmarshalText(ParseOptions{SpaceSeparator: true}, data)
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v DateTimeSQL) MarshalJSON() ([]byte, error) {
+	return DateTime(v).marshalJSONLayout(SQLDateTime), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *DateTimeSQL) UnmarshalJSON(data []byte) error {
+	return (*DateTime)(v).unmarshalJSON(ParseOptions{SpaceSeparator: true}, data)
+}
+
+func (dt DateTime) marshalJSONLayout(layout string) []byte {
+	b := append(make([]byte, 0, len(layout)+2), '"')
+	b = dt.AppendFormat(b, layout)
+	return append(b, '"')
+}
+
+func (dt *DateTime) unmarshalLayout(layout, s string) error {
+	val, err := ParseDateTimeLayout(layout, s)
+	if err != nil {
+		return err
+	}
+	*dt = val
+	return nil
+}
+
+func (dt *DateTime) unmarshalJSONLayout(layout string, data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	s, err := jsonString(data, "datetime")
+	if err != nil {
+		return err
+	}
+	return dt.unmarshalLayout(layout, s)
+}
+
+// jsonString returns the JSON string data, or an error wrapping
+// ErrInvalidFormat if data is not a string. The kind names the value
+// expected, such as "date".
+func jsonString(data []byte, kind string) (string, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", fmt.Errorf("%w: %s should be a string, got %s", ErrInvalidFormat, kind, data)
+	}
+	return s, nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateFormats_JSON(t *testing.T) {
+	type Invoice struct {
+		Issued  DateUS      `json:"issued"`
+		Due     DateEU      `json:"due"`
+		Batch   DateCompact `json:"batch"`
+		Created Date        `json:"created"`
+	}
+	d := Date{2020, 2, 29}
+	in := Invoice{DateUS(d), DateEU(d), DateCompact(d), d}
+	b, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"issued":"02/29/2020","due":"29.02.2020","batch":"20200229","created":"2020-02-29"}`, string(b))
+
+	var out Invoice
+	assert.NoError(t, json.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+	assert.Equal(t, d, Date(out.Issued))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"issued":null}`), &out))
+	assert.Equal(t, in, out)
+}
+
+func TestDateFormats_Text(t *testing.T) {
+	d := Date{2021, 3, 4}
+	assert.Equal(t, "03/04/2021", DateUS(d).String())
+	assert.Equal(t, "04.03.2021", DateEU(d).String())
+	assert.Equal(t, "20210304", DateCompact(d).String())
+
+	b, err := json.Marshal(map[DateEU]int{DateEU(d): 1})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"04.03.2021":1}`, string(b))
+
+	var v DateCompact
+	assert.NoError(t, v.UnmarshalText([]byte("20210304")))
+	assert.Equal(t, d, Date(v))
+}
+
+func TestDateTimeFormats_JSON(t *testing.T) {
+	type Event struct {
+		Local   DateTimeUS      `json:"local"`
+		Booked  DateTimeEU      `json:"booked"`
+		Stamp   DateTimeCompact `json:"stamp"`
+		Created DateTime        `json:"created"`
+	}
+	dt := DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}
+	in := Event{DateTimeUS(dt), DateTimeEU(dt), DateTimeCompact(dt), dt}
+	b, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"local":"02/29/2020 03:42:31","booked":"29.02.2020 03:42:31","stamp":"20200229T034231","created":"2020-02-29T03:42:31"}`, string(b))
+
+	var out Event
+	assert.NoError(t, json.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+	assert.Equal(t, dt, DateTime(out.Local))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"local":null}`), &out))
+	assert.Equal(t, in, out)
+}
+
+func TestDateTimeFormats_Text(t *testing.T) {
+	dt := DateTime{Date{2021, 3, 4}, Time{15, 4, 5, 500000000}}
+	assert.Equal(t, "03/04/2021 15:04:05.5", DateTimeUS(dt).String())
+	assert.Equal(t, "04.03.2021 15:04:05.5", DateTimeEU(dt).String())
+	assert.Equal(t, "20210304T150405.5", DateTimeCompact(dt).String())
+
+	var v DateTimeEU
+	assert.NoError(t, v.UnmarshalText([]byte("04.03.2021 15:04:05.5")))
+	assert.Equal(t, dt, DateTime(v))
+}
+
+/* === ERRORS === */
+
+func TestDateFormats_Errors(t *testing.T) {
+	var us DateUS
+	assert.Error(t, us.UnmarshalJSON([]byte(`"29/02/2020"`)))
+	assert.Error(t, us.UnmarshalJSON([]byte(`"2020-02-29"`)))
+	err := us.UnmarshalJSON([]byte(`20200229`))
+	assert.True(t, errors.Is(err, ErrInvalidFormat), err)
+	assert.EqualError(t, err, "civil: invalid format: date should be a string, got 20200229")
+
+	var eu DateEU
+	assert.Error(t, eu.UnmarshalText([]byte("30.02.2020")))
+
+	var c DateCompact
+	assert.Error(t, c.UnmarshalText([]byte("2020-02-29")))
+	assert.Equal(t, DateCompact{}, c)
+}
+
+func TestDateTimeFormats_Errors(t *testing.T) {
+	var us DateTimeUS
+	assert.Error(t, us.UnmarshalJSON([]byte(`"29/02/2020 03:42:31"`)))
+	assert.Error(t, us.UnmarshalJSON([]byte(`"02/29/2020"`)))
+	err := us.UnmarshalJSON([]byte(`20200229`))
+	assert.True(t, errors.Is(err, ErrInvalidFormat), err)
+
+	var eu DateTimeEU
+	assert.Error(t, eu.UnmarshalText([]byte("30.02.2020 03:42:31")))
+
+	var c DateTimeCompact
+	assert.Error(t, c.UnmarshalText([]byte("2020-02-29T03:42:31")))
+	assert.Equal(t, DateTimeCompact{}, c)
+}
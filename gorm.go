@@ -0,0 +1,37 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+// The GormDataType methods implement the schema.GormDataTypeInterface of
+// gorm.io/gorm without importing it, so that migrations create columns of
+// the right SQL type. The upper-case names are not among GORM's generic
+// data types, so every dialect uses them as written. MySQL users should tag
+// DateTime fields with `gorm:"type:datetime(6)"`, since a MySQL TIMESTAMP
+// is converted to UTC and limited to the years 1970 to 2038.
+
+// GormDataType returns the SQL column type for a Date.
+func (Date) GormDataType() string {
+	return "DATE"
+}
+
+// GormDataType returns the SQL column type for a Time.
+func (Time) GormDataType() string {
+	return "TIME"
+}
+
+// GormDataType returns the SQL column type for a DateTime.
+func (DateTime) GormDataType() string {
+	return "TIMESTAMP"
+}
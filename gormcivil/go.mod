@@ -0,0 +1,21 @@
+module github.com/openlyinc/civil/gormcivil
+
+go 1.18
+
+require (
+	github.com/openlyinc/civil v0.0.0
+	github.com/stretchr/testify v1.4.0
+	gorm.io/gorm v1.25.12
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	gopkg.in/yaml.v2 v2.2.2 // indirect
+)
+
+replace github.com/openlyinc/civil => ../
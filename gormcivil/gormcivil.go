@@ -0,0 +1,77 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gormcivil provides a GORM serializer for civil values.
+//
+// The civil types already implement sql.Scanner and driver.Valuer, and
+// report their column types to migrations through GormDataType, so they can
+// be used in GORM models directly. A NULL cannot be scanned into them,
+// however. Fields tagged with the "civil" serializer map NULL to the zero
+// value and back, which suits optional dates in models that avoid pointers:
+//
+//	type Employee struct {
+//		ID     uint
+//		Hired  civil.Date
+//		LeftOn civil.Date `gorm:"serializer:civil"`
+//	}
+//
+// The serializer is registered by importing this package.
+package gormcivil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+)
+
+func init() {
+	schema.RegisterSerializer("civil", Serializer{})
+}
+
+// Serializer is a schema.SerializerInterface that stores the zero value of
+// a civil.Date, civil.Time or civil.DateTime field as NULL and scans NULL
+// as the zero value.
+type Serializer struct{}
+
+// Scan implements the schema.SerializerInterface interface.
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	fieldValue := reflect.New(field.FieldType)
+	if dbValue != nil {
+		scanner, ok := fieldValue.Interface().(sql.Scanner)
+		if !ok {
+			return fmt.Errorf("gormcivil: field %s of type %v is not a civil type", field.Name, field.FieldType)
+		}
+		if err := scanner.Scan(dbValue); err != nil {
+			return err
+		}
+	}
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return nil
+}
+
+// Value implements the schema.SerializerValuerInterface interface.
+func (Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	valuer, ok := fieldValue.(driver.Valuer)
+	if !ok {
+		return nil, fmt.Errorf("gormcivil: field %s of type %T is not a civil type", field.Name, fieldValue)
+	}
+	if reflect.ValueOf(fieldValue).IsZero() {
+		return nil, nil
+	}
+	return valuer.Value()
+}
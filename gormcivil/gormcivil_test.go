@@ -0,0 +1,77 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gormcivil
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/openlyinc/civil"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/schema"
+)
+
+type employee struct {
+	LeftOn civil.Date
+	Name   string
+}
+
+func field(name string) *schema.Field {
+	f, _ := reflect.TypeOf(employee{}).FieldByName(name)
+	return &schema.Field{
+		Name:      name,
+		FieldType: f.Type,
+		ReflectValueOf: func(ctx context.Context, v reflect.Value) reflect.Value {
+			return reflect.Indirect(v).FieldByIndex(f.Index)
+		},
+	}
+}
+
+func TestSerializer(t *testing.T) {
+	var s schema.SerializerInterface = Serializer{}
+	ctx := context.Background()
+	e := employee{}
+	dst := reflect.ValueOf(&e)
+
+	assert.NoError(t, s.Scan(ctx, field("LeftOn"), dst, "2020-02-29"))
+	assert.Equal(t, civil.Date{Year: 2020, Month: 2, Day: 29}, e.LeftOn)
+	v, err := s.Value(ctx, field("LeftOn"), dst, e.LeftOn)
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-02-29", v)
+
+	assert.NoError(t, s.Scan(ctx, field("LeftOn"), dst, nil))
+	assert.Equal(t, civil.Date{}, e.LeftOn)
+	v, err = s.Value(ctx, field("LeftOn"), dst, e.LeftOn)
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+/* === ERRORS === */
+
+func TestSerializer_Errors(t *testing.T) {
+	ctx := context.Background()
+	e := employee{LeftOn: civil.Date{Year: 2020, Month: 2, Day: 29}}
+	dst := reflect.ValueOf(&e)
+
+	err := Serializer{}.Scan(ctx, field("LeftOn"), dst, 42)
+	assert.True(t, errors.Is(err, civil.ErrUnsupportedType))
+	assert.Equal(t, civil.Date{Year: 2020, Month: 2, Day: 29}, e.LeftOn)
+
+	assert.Error(t, Serializer{}.Scan(ctx, field("Name"), dst, "x"))
+	_, err = Serializer{}.Value(ctx, field("Name"), dst, "x")
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build goexperiment.jsonv2
+// +build goexperiment.jsonv2
+
+package civil
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+)
+
+// The json v2 methods make encoding/json/v2 write civil values straight
+// into the encoder's buffer. They are built only with GOEXPERIMENT=jsonv2,
+// and decode exactly as the UnmarshalJSON methods do.
+//
+// The format option of a struct field tag, such as `json:",format:unix"`,
+// is not supported: encoding/json/v2 does not pass it to MarshalJSONTo or
+// UnmarshalJSONFrom, and reports an error for a civil field that has one.
+// Civil values always use the RFC 3339 forms written by AppendJSON.
+var (
+	_ json.MarshalerTo     = Date{}
+	_ json.UnmarshalerFrom = (*Date)(nil)
+	_ json.MarshalerTo     = Time{}
+	_ json.UnmarshalerFrom = (*Time)(nil)
+	_ json.MarshalerTo     = DateTime{}
+	_ json.UnmarshalerFrom = (*DateTime)(nil)
+)
+
+// MarshalJSONTo implements the encoding/json/v2 MarshalerTo interface.
+func (d Date) MarshalJSONTo(enc *jsontext.Encoder) error {
+	b, err := d.AppendJSON(enc.AvailableBuffer())
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(b)
+}
+
+// UnmarshalJSONFrom implements the encoding/json/v2 UnmarshalerFrom
+// interface.
+func (d *Date) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	val, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalJSON(val)
+}
+
+// MarshalJSONTo implements the encoding/json/v2 MarshalerTo interface.
+func (t Time) MarshalJSONTo(enc *jsontext.Encoder) error {
+	b, err := t.AppendJSON(enc.AvailableBuffer())
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(b)
+}
+
+// UnmarshalJSONFrom implements the encoding/json/v2 UnmarshalerFrom
+// interface.
+func (t *Time) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	val, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalJSON(val)
+}
+
+// MarshalJSONTo implements the encoding/json/v2 MarshalerTo interface.
+func (dt DateTime) MarshalJSONTo(enc *jsontext.Encoder) error {
+	b, err := dt.AppendJSON(enc.AvailableBuffer())
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(b)
+}
+
+// UnmarshalJSONFrom implements the encoding/json/v2 UnmarshalerFrom
+// interface.
+func (dt *DateTime) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	val, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return dt.UnmarshalJSON(val)
+}
@@ -0,0 +1,86 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build goexperiment.jsonv2
+// +build goexperiment.jsonv2
+
+package civil
+
+import (
+	"encoding/json/v2"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONv2(t *testing.T) {
+	type Event struct {
+		Day   Date
+		Start Time
+		At    *DateTime
+	}
+	in := Event{Date{2020, 2, 29}, Time{Hour: 9}, &DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876000000}}}
+	b, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Day":"2020-02-29","Start":"09:00:00","At":"2020-02-29T03:42:31.876000000"}`, string(b))
+
+	var out Event
+	assert.NoError(t, json.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+
+	out = Event{Day: Date{2021, 3, 4}}
+	assert.NoError(t, json.Unmarshal([]byte(`{"Day":null,"At":null}`), &out))
+	assert.Equal(t, Event{Day: Date{2021, 3, 4}}, out)
+}
+
+/* === ERRORS === */
+
+func TestJSONv2_Errors(t *testing.T) {
+	_, err := json.Marshal(Date{Year: 10000, Month: 1, Day: 1})
+	assert.True(t, errors.Is(err, ErrYearOutOfRange), err)
+
+	var d Date
+	err = json.Unmarshal([]byte(`"2020-02-30"`), &d)
+	assert.True(t, errors.Is(err, ErrInvalidDay), err)
+	assert.Error(t, json.Unmarshal([]byte(`20200229`), &d))
+}
+
+func TestJSONv2_Format(t *testing.T) {
+	type TC struct {
+		Name string
+		In   interface{}
+	}
+	tcs := []TC{
+		TC{Name: "Date", In: &struct {
+			V Date `json:",format:unix"`
+		}{}},
+		TC{Name: "Time", In: &struct {
+			V Time `json:",format:'15:04'"`
+		}{}},
+		TC{Name: "DateTime", In: &struct {
+			V DateTime `json:",format:RFC1123"`
+		}{}},
+	}
+	for _, tc := range tcs {
+		_, err := json.Marshal(tc.In)
+		if assert.Error(t, err, tc.Name) {
+			assert.Contains(t, err.Error(), "unsupported `format` tag option", tc.Name)
+		}
+		err = json.Unmarshal([]byte(`{"V":null}`), tc.In)
+		if assert.Error(t, err, tc.Name) {
+			assert.Contains(t, err.Error(), "unsupported `format` tag option", tc.Name)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// unixJulianDayNumber is the Julian Day Number of 1970-01-01.
+const unixJulianDayNumber = 2440588
+
+// unixMJD is the Modified Julian Date of 1970-01-01.
+const unixMJD = 40587
+
+// JulianDay returns the Julian Day Number of d, the number of days since
+// November 24, 4714 BC in the proleptic Gregorian calendar (year -4713 here).
+// Julian days start at noon, and the number is that of the Julian day
+// starting at noon on d, so it is the integer part of SQLite's
+// julianday(d) + 0.5, or of julianday(d, '+12 hours').
+func (d Date) JulianDay() int64 {
+	return d.DaysSinceEpoch() + unixJulianDayNumber
+}
+
+// DateFromJulianDay returns the date whose Julian Day Number is jd.
+func DateFromJulianDay(jd int64) Date {
+	return FromEpochDays(jd - unixJulianDayNumber)
+}
+
+// MJD returns the Modified Julian Date of d, the number of days since
+// 1858-11-17. Modified Julian days start at midnight, so the MJD of a date
+// is its Julian Day Number minus 2400001.
+func (d Date) MJD() int64 {
+	return d.DaysSinceEpoch() + unixMJD
+}
+
+// FromMJD returns the date whose Modified Julian Date is mjd.
+func FromMJD(mjd int64) Date {
+	return FromEpochDays(mjd - unixMJD)
+}
+
+// MJD returns the Modified Julian Date of dt with the time of day as the
+// fraction of the day, as used to timestamp satellite and telemetry data.
+// A float64 holds it to about a microsecond for dates in recent centuries.
+// The fraction is truncated to the microsecond.
+func (dt DateTime) MJD() float64 {
+	us := int64(dt.Time.sinceMidnight() / time.Microsecond)
+	return float64(dt.Date.MJD()) + float64(us)/float64(dayMicros)
+}
+
+// DateTimeFromMJD returns the datetime of a fractional Modified Julian Date,
+// rounded to the microsecond. It returns an error if mjd is not finite or
+// the year is outside [MinYear,MaxYear].
+func DateTimeFromMJD(mjd float64) (DateTime, error) {
+	us := math.Round((mjd - unixMJD) * float64(dayMicros))
+	if math.IsNaN(us) || math.Abs(us) > 1<<62 {
+		return DateTime{}, fmt.Errorf("%w: %v is not a Modified Julian Date", ErrInvalidFormat, mjd)
+	}
+	dt := dateTimeFromLocalTimestamp(int64(us), time.Microsecond)
+	if err := checkYear(dt.Date.Year); err != nil {
+		return DateTime{}, err
+	}
+	return dt, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_JulianDay(t *testing.T) {
+	type TC struct {
+		In  Date
+		Out int64
+	}
+	tcs := []TC{
+		TC{In: Date{1970, 1, 1}, Out: 2440588},
+		TC{In: Date{2000, 1, 1}, Out: 2451545},
+		TC{In: Date{2020, 2, 29}, Out: 2458909},
+		TC{In: Date{1858, 11, 17}, Out: 2400001},
+		TC{In: Date{1582, 10, 15}, Out: 2299161},
+		TC{In: Date{0, 1, 1}, Out: 1721060},
+		TC{In: Date{-4713, 11, 24}, Out: 0},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.JulianDay(), "%v", tc.In)
+		assert.Equal(t, tc.In, DateFromJulianDay(tc.Out), "%d", tc.Out)
+	}
+}
+
+func TestDate_MJD(t *testing.T) {
+	type TC struct {
+		In  Date
+		Out int64
+	}
+	tcs := []TC{
+		TC{In: Date{1858, 11, 17}, Out: 0},
+		TC{In: Date{1858, 11, 16}, Out: -1},
+		TC{In: Date{1970, 1, 1}, Out: 40587},
+		TC{In: Date{2000, 1, 1}, Out: 51544},
+		TC{In: Date{2020, 2, 29}, Out: 58908},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.MJD(), "%v", tc.In)
+		assert.Equal(t, tc.In.JulianDay()-2400001, tc.In.MJD(), "%v", tc.In)
+		assert.Equal(t, tc.In, FromMJD(tc.Out), "%d", tc.Out)
+	}
+}
+
+func TestDateTime_MJD(t *testing.T) {
+	type TC struct {
+		In  DateTime
+		Out float64
+	}
+	tcs := []TC{
+		TC{In: DateTime{Date{1858, 11, 17}, Time{}}, Out: 0},
+		TC{In: DateTime{Date{2000, 1, 1}, Time{12, 0, 0, 0}}, Out: 51544.5},
+		TC{In: DateTime{Date{2020, 2, 29}, Time{6, 0, 0, 0}}, Out: 58908.25},
+		TC{In: DateTime{Date{1858, 11, 16}, Time{18, 0, 0, 0}}, Out: -0.25},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.MJD(), "%v", tc.In)
+
+		dt, err := DateTimeFromMJD(tc.Out)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.In, dt, "%v", tc.Out)
+	}
+
+	dt, err := DateTimeFromMJD(58908.123456789)
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{2, 57, 46, 666570000}}, dt)
+
+	// Beyond the range of a timestamp in microseconds.
+	far := DateTime{Date{1000000, 1, 1}, Time{12, 0, 0, 0}}
+	assert.Equal(t, float64(far.Date.MJD())+0.5, far.MJD())
+	assert.Equal(t, float64(Date{-1000000, 1, 1}.MJD()), DateTime{Date: Date{-1000000, 1, 1}}.MJD())
+}
+
+/* === ERRORS === */
+
+func TestDateTimeFromMJD_Errors(t *testing.T) {
+	for _, mjd := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, err := DateTimeFromMJD(mjd)
+		assert.True(t, errors.Is(err, ErrInvalidFormat), "%v", mjd)
+	}
+	_, err := DateTimeFromMJD(1e7)
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+}
@@ -0,0 +1,217 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// The msgpack methods implement the Marshaler and Unmarshaler interfaces of
+// github.com/vmihailenco/msgpack without importing it. Values are written
+// as msgpack strings in the format written by String. Decoding accepts
+// strings, parsed as by UnmarshalText, and the msgpack timestamp extension
+// (type -1), whose UTC wall-clock time is used, and decodes nil as the zero
+// value.
+
+// msgpack format bytes, from the MessagePack specification.
+const (
+	msgpackNil      = 0xc0
+	msgpackFixStr   = 0xa0
+	msgpackStr8     = 0xd9
+	msgpackStr16    = 0xda
+	msgpackStr32    = 0xdb
+	msgpackExt8     = 0xc7
+	msgpackFixExt4  = 0xd6
+	msgpackFixExt8  = 0xd7
+	msgpackTimeType = 0xff
+)
+
+// MarshalMsgpack writes the date as a msgpack string.
+func (d Date) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, d.String()), nil
+}
+
+// UnmarshalMsgpack decodes a msgpack string, timestamp or nil.
+func (d *Date) UnmarshalMsgpack(data []byte) error {
+	s, t, isNil, err := decodeMsgpack(data, "Date")
+	if err != nil {
+		return err
+	}
+	var val Date
+	switch {
+	case isNil:
+	case s != "":
+		if val, err = decodeOptions().ParseDate(s); err != nil {
+			return err
+		}
+	default:
+		val = DateOf(t)
+	}
+	*d = val
+	return nil
+}
+
+// MarshalMsgpack writes the time as a msgpack string.
+func (t Time) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.String()), nil
+}
+
+// UnmarshalMsgpack decodes a msgpack string, timestamp or nil. A timestamp
+// is converted to its UTC time of day.
+func (t *Time) UnmarshalMsgpack(data []byte) error {
+	s, tm, isNil, err := decodeMsgpack(data, "Time")
+	if err != nil {
+		return err
+	}
+	var val Time
+	switch {
+	case isNil:
+	case s != "":
+		if val, err = decodeOptions().ParseTime(s); err != nil {
+			return err
+		}
+	default:
+		val = TimeOf(tm)
+	}
+	*t = val
+	return nil
+}
+
+// MarshalMsgpack writes the datetime as a msgpack string.
+func (dt DateTime) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, dt.String()), nil
+}
+
+// UnmarshalMsgpack decodes a msgpack string, timestamp or nil.
+func (dt *DateTime) UnmarshalMsgpack(data []byte) error {
+	s, t, isNil, err := decodeMsgpack(data, "DateTime")
+	if err != nil {
+		return err
+	}
+	var val DateTime
+	switch {
+	case isNil:
+	case s != "":
+		if val, err = decodeOptions().ParseDateTime(s); err != nil {
+			return err
+		}
+	default:
+		val = DateTimeOf(t)
+	}
+	*dt = val
+	return nil
+}
+
+// appendMsgpackString appends s in the shortest msgpack str format.
+func appendMsgpackString(b []byte, s string) []byte {
+	switch n := len(s); {
+	case n < 32:
+		b = append(b, msgpackFixStr|byte(n))
+	case n <= 0xff:
+		b = append(b, msgpackStr8, byte(n))
+	case n <= 0xffff:
+		b = append(b, msgpackStr16, byte(n>>8), byte(n))
+	default:
+		b = append(b, msgpackStr32, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+	return append(b, s...)
+}
+
+// decodeMsgpack decodes a single msgpack string, timestamp or nil that
+// makes up all of data. It returns the string, or the timestamp in UTC, or
+// reports nil. An empty string is reported as invalid, since no civil value
+// is written that way.
+func decodeMsgpack(data []byte, typeName string) (s string, t time.Time, isNil bool, err error) {
+	if len(data) == 0 {
+		return "", time.Time{}, false, fmt.Errorf("%w: empty msgpack value", ErrInvalidFormat)
+	}
+	malformed := fmt.Errorf("%w: malformed msgpack value", ErrInvalidFormat)
+
+	var n, size int
+	switch c := data[0]; {
+	case c == msgpackNil:
+		if len(data) != 1 {
+			return "", time.Time{}, false, malformed
+		}
+		return "", time.Time{}, true, nil
+	case c&0xe0 == msgpackFixStr:
+		n = int(c & 0x1f)
+	case c == msgpackStr8, c == msgpackStr16, c == msgpackStr32:
+		size = 1 << (c - msgpackStr8)
+	case c == msgpackFixExt4, c == msgpackFixExt8, c == msgpackExt8:
+		t, err = decodeMsgpackTimestamp(data, typeName)
+		return "", t, false, err
+	default:
+		return "", time.Time{}, false, fmt.Errorf("%w: cannot decode msgpack format 0x%02x into %s", ErrUnsupportedType, c, typeName)
+	}
+
+	data = data[1:]
+	if len(data) < size {
+		return "", time.Time{}, false, malformed
+	}
+	for _, c := range data[:size] {
+		n = n<<8 | int(c)
+	}
+	data = data[size:]
+	if len(data) != n || n == 0 {
+		return "", time.Time{}, false, malformed
+	}
+	return string(data), time.Time{}, false, nil
+}
+
+// decodeMsgpackTimestamp decodes the timestamp extension in its 32-, 64- and
+// 96-bit forms.
+func decodeMsgpackTimestamp(data []byte, typeName string) (time.Time, error) {
+	var typ byte
+	var body []byte
+	switch data[0] {
+	case msgpackFixExt4, msgpackFixExt8:
+		if len(data) < 2 {
+			break
+		}
+		typ, body = data[1], data[2:]
+		if want := 4 << (data[0] - msgpackFixExt4); len(body) != want {
+			body = nil
+		}
+	case msgpackExt8:
+		if len(data) < 3 || int(data[1]) != len(data)-3 || data[1] != 12 {
+			break
+		}
+		typ, body = data[2], data[3:]
+	}
+	if body == nil {
+		return time.Time{}, fmt.Errorf("%w: malformed msgpack timestamp", ErrInvalidFormat)
+	}
+	if typ != msgpackTimeType {
+		return time.Time{}, fmt.Errorf("%w: cannot decode msgpack extension type %d into %s", ErrUnsupportedType, int8(typ), typeName)
+	}
+
+	var sec, nsec int64
+	switch len(body) {
+	case 4:
+		sec = int64(binary.BigEndian.Uint32(body))
+	case 8:
+		v := binary.BigEndian.Uint64(body)
+		nsec, sec = int64(v>>34), int64(v&(1<<34-1))
+	case 12:
+		nsec, sec = int64(binary.BigEndian.Uint32(body)), int64(binary.BigEndian.Uint64(body[4:]))
+	}
+	if nsec >= 1e9 {
+		return time.Time{}, fmt.Errorf("%w: msgpack timestamp nanoseconds %d out of range", ErrInvalidFormat, nsec)
+	}
+	return time.Unix(sec, nsec).UTC(), nil
+}
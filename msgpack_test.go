@@ -0,0 +1,103 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgpack_String(t *testing.T) {
+	data, err := Date{2020, 2, 29}.MarshalMsgpack()
+	assert.NoError(t, err)
+	assert.Equal(t, append([]byte{0xaa}, "2020-02-29"...), data)
+	var d Date
+	assert.NoError(t, d.UnmarshalMsgpack(data))
+	assert.Equal(t, Date{2020, 2, 29}, d)
+
+	tm := Time{3, 42, 31, 876}
+	data, err = tm.MarshalMsgpack()
+	assert.NoError(t, err)
+	var tm0 Time
+	assert.NoError(t, tm0.UnmarshalMsgpack(data))
+	assert.Equal(t, tm, tm0)
+
+	dt := DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876}}
+	data, err = dt.MarshalMsgpack()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0xa0|29), data[0])
+	var dt0 DateTime
+	assert.NoError(t, dt0.UnmarshalMsgpack(data))
+	assert.Equal(t, dt, dt0)
+
+	assert.NoError(t, dt0.UnmarshalMsgpack([]byte{0xc0}))
+	assert.Equal(t, DateTime{}, dt0)
+
+	// Longer strings use the str 8 format.
+	long := "2020-02-29T03:42:31.000000876000"
+	data = appendMsgpackString(nil, long)
+	assert.Equal(t, []byte{0xd9, 32}, data[:2])
+	s, _, _, err := decodeMsgpack(data, "DateTime")
+	assert.NoError(t, err)
+	assert.Equal(t, long, s)
+}
+
+func TestMsgpack_Timestamp(t *testing.T) {
+	// 2020-02-29T03:42:31Z in the 32-bit form.
+	ts32 := []byte{0xd6, 0xff, 0x5e, 0x59, 0xdd, 0xa7}
+	var dt DateTime
+	assert.NoError(t, dt.UnmarshalMsgpack(ts32))
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}, dt)
+
+	// The same instant plus 500ms in the 64-bit form.
+	ts64 := []byte{0xd7, 0xff, 0x77, 0x35, 0x94, 0x00, 0x5e, 0x59, 0xdd, 0xa7}
+	var tm Time
+	assert.NoError(t, tm.UnmarshalMsgpack(ts64))
+	assert.Equal(t, Time{3, 42, 31, 500000000}, tm)
+
+	// 0001-01-01T00:00:00Z in the 96-bit form.
+	ts96 := []byte{0xc7, 12, 0xff, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0xf1, 0x88, 0x6e, 0x09, 0x00}
+	var d Date
+	assert.NoError(t, d.UnmarshalMsgpack(ts96))
+	assert.Equal(t, Date{1, 1, 1}, d)
+}
+
+/* === ERRORS === */
+
+func TestMsgpack_Errors(t *testing.T) {
+	type TC struct {
+		In  []byte
+		Err error
+	}
+	tcs := []TC{
+		TC{In: nil, Err: ErrInvalidFormat},
+		TC{In: []byte{0xa0}, Err: ErrInvalidFormat},
+		TC{In: []byte{0xaa, '2', '0'}, Err: ErrInvalidFormat},
+		TC{In: []byte{0xd9}, Err: ErrInvalidFormat},
+		TC{In: []byte{0xc0, 0xc0}, Err: ErrInvalidFormat},
+		TC{In: []byte{0xd6, 0xff, 0x5e}, Err: ErrInvalidFormat},
+		TC{In: []byte{0xd7, 0xff, 0xff, 0xff, 0xff, 0xfc, 0, 0, 0, 0}, Err: ErrInvalidFormat},
+		TC{In: []byte{0xd6, 0x01, 0x5e, 0x59, 0xdd, 0xa7}, Err: ErrUnsupportedType},
+		TC{In: []byte{0xce, 0x5e, 0x59, 0xdd, 0xa7}, Err: ErrUnsupportedType},
+		TC{In: append([]byte{0xaa}, "2020-02-30"...), Err: ErrInvalidDay},
+	}
+	for _, tc := range tcs {
+		var d Date
+		err := d.UnmarshalMsgpack(tc.In)
+		assert.True(t, errors.Is(err, tc.Err), "%x: %v", tc.In, err)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql/driver"
+)
+
+// NullDate represents a Date that may be null, in the manner of
+// sql.NullTime. It implements the sql.Scanner and driver.Valuer interfaces
+// for nullable DATE columns, and JSON null is its encoding when not Valid.
+//
+// Pointers serve as well with database/sql and the libraries built on it,
+// such as sqlx: a nil *Date, *Time or *DateTime argument is written as NULL,
+// and scanning into a **Date sets it to nil for NULL and to a newly
+// allocated Date otherwise. (*Date)(nil).Value itself cannot return NULL,
+// as Value has a value receiver so that Date is a driver.Valuer.
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// Scan implements the database/sql scanner interface.
+func (n *NullDate) Scan(value interface{}) error {
+	return n.scan(SQLOptions{}, value)
+}
+
+// scan is like Scan but follows the options o.
+func (n *NullDate) scan(o SQLOptions, value interface{}) error {
+	if o.scansAsNull(value) {
+		*n = NullDate{}
+		return nil
+	}
+	var d Date
+	if err := d.scan(o, value); err != nil {
+		return err
+	}
+	*n = NullDate{Date: d, Valid: true}
+	return nil
+}
+
+// Value implements the database/sql/driver valuer interface.
+func (n NullDate) Value() (driver.Value, error) {
+	return n.value(SQLOptions{})
+}
+
+// value is like Value but follows the options o.
+func (n NullDate) value(o SQLOptions) (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.value(o)
+}
+
+// MarshalJSON implements encoding/json Marshaler interface.
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Date.MarshalJSON()
+}
+
+// UnmarshalJSON implements encoding/json Unmarshaler interface.
+func (n *NullDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = NullDate{}
+		return nil
+	}
+	var d Date
+	if err := d.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*n = NullDate{Date: d, Valid: true}
+	return nil
+}
+
+// NullTime represents a Time that may be null. It is the counterpart of
+// NullDate for TIME columns.
+type NullTime struct {
+	Time  Time
+	Valid bool // Valid is true if Time is not NULL
+}
+
+// Scan implements the database/sql scanner interface.
+func (n *NullTime) Scan(value interface{}) error {
+	return n.scan(SQLOptions{}, value)
+}
+
+// scan is like Scan but follows the options o.
+func (n *NullTime) scan(o SQLOptions, value interface{}) error {
+	if timeSource(value) == nil {
+		*n = NullTime{}
+		return nil
+	}
+	var t Time
+	if err := t.scan(o, value); err != nil {
+		return err
+	}
+	*n = NullTime{Time: t, Valid: true}
+	return nil
+}
+
+// Value implements the database/sql/driver valuer interface.
+func (n NullTime) Value() (driver.Value, error) {
+	return n.value(SQLOptions{})
+}
+
+// value is like Value but follows the options o.
+func (n NullTime) value(o SQLOptions) (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Time.value(o)
+}
+
+// MarshalJSON implements encoding/json Marshaler interface.
+func (n NullTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Time.MarshalJSON()
+}
+
+// UnmarshalJSON implements encoding/json Unmarshaler interface.
+func (n *NullTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = NullTime{}
+		return nil
+	}
+	var t Time
+	if err := t.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*n = NullTime{Time: t, Valid: true}
+	return nil
+}
+
+// NullDateTime represents a DateTime that may be null. It is the
+// counterpart of NullDate for DATETIME and TIMESTAMP WITHOUT TIME ZONE
+// columns.
+type NullDateTime struct {
+	DateTime DateTime
+	Valid    bool // Valid is true if DateTime is not NULL
+}
+
+// Scan implements the database/sql scanner interface.
+func (n *NullDateTime) Scan(value interface{}) error {
+	return n.scan(SQLOptions{}, value)
+}
+
+// scan is like Scan but follows the options o.
+func (n *NullDateTime) scan(o SQLOptions, value interface{}) error {
+	if o.scansAsNull(value) {
+		*n = NullDateTime{}
+		return nil
+	}
+	var dt DateTime
+	if err := dt.scan(o, value); err != nil {
+		return err
+	}
+	*n = NullDateTime{DateTime: dt, Valid: true}
+	return nil
+}
+
+// Value implements the database/sql/driver valuer interface.
+func (n NullDateTime) Value() (driver.Value, error) {
+	return n.value(SQLOptions{})
+}
+
+// value is like Value but follows the options o.
+func (n NullDateTime) value(o SQLOptions) (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.DateTime.value(o)
+}
+
+// MarshalJSON implements encoding/json Marshaler interface.
+func (n NullDateTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.DateTime.MarshalJSON()
+}
+
+// UnmarshalJSON implements encoding/json Unmarshaler interface.
+func (n *NullDateTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = NullDateTime{}
+		return nil
+	}
+	var dt DateTime
+	if err := dt.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*n = NullDateTime{DateTime: dt, Valid: true}
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ sql.Scanner   = (*NullDate)(nil)
+	_ driver.Valuer = NullDate{}
+	_ sql.Scanner   = (*NullTime)(nil)
+	_ driver.Valuer = NullTime{}
+	_ sql.Scanner   = (*NullDateTime)(nil)
+	_ driver.Valuer = NullDateTime{}
+)
+
+func TestNullDate(t *testing.T) {
+	var n NullDate
+	assert.NoError(t, n.Scan("2020-02-29"))
+	assert.Equal(t, NullDate{Date{2020, 2, 29}, true}, n)
+	v, err := n.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-02-29", v)
+
+	assert.NoError(t, n.Scan(nil))
+	assert.Equal(t, NullDate{}, n)
+	v, err = n.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	assert.NoError(t, n.Scan(time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, NullDate{Date{2021, 3, 4}, true}, n)
+}
+
+func TestNullTime(t *testing.T) {
+	var n NullTime
+	assert.NoError(t, n.Scan("03:42:31"))
+	assert.Equal(t, NullTime{Time{3, 42, 31, 0}, true}, n)
+	v, err := n.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "03:42:31", v)
+
+	assert.NoError(t, n.Scan(nil))
+	assert.Equal(t, NullTime{}, n)
+}
+
+func TestNullDateTime(t *testing.T) {
+	var n NullDateTime
+	assert.NoError(t, n.Scan("2020-02-29T03:42:31"))
+	assert.Equal(t, NullDateTime{DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}, true}, n)
+
+	assert.NoError(t, n.Scan(nil))
+	v, err := n.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+// echoDriver is a database/sql driver whose queries return one row holding
+// the query arguments, so that values take the same path through
+// database/sql as with a real database.
+type echoDriver struct{}
+
+func (echoDriver) Open(string) (driver.Conn, error) { return echoConn{}, nil }
+
+type echoConn struct{}
+
+func (echoConn) Prepare(string) (driver.Stmt, error) { return echoStmt{}, nil }
+func (echoConn) Close() error                        { return nil }
+func (echoConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+type echoStmt struct{}
+
+func (echoStmt) Close() error                                   { return nil }
+func (echoStmt) NumInput() int                                  { return -1 }
+func (echoStmt) Exec([]driver.Value) (driver.Result, error)     { return nil, driver.ErrSkip }
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) { return &echoRows{row: args}, nil }
+
+type echoRows struct {
+	row  []driver.Value
+	done bool
+}
+
+func (r *echoRows) Columns() []string { return make([]string, len(r.row)) }
+func (r *echoRows) Close() error      { return nil }
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.row)
+	return nil
+}
+
+func init() {
+	sql.Register("civil-echo", echoDriver{})
+}
+
+func TestNull_Pointers(t *testing.T) {
+	db, err := sql.Open("civil-echo", "")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	d := &Date{2020, 2, 29}
+	assert.NoError(t, db.QueryRow("SELECT ?", (*Date)(nil)).Scan(&d))
+	assert.Nil(t, d)
+	assert.NoError(t, db.QueryRow("SELECT ?", &Date{2020, 2, 29}).Scan(&d))
+	assert.Equal(t, &Date{2020, 2, 29}, d)
+
+	tm := &Time{Hour: 3}
+	assert.NoError(t, db.QueryRow("SELECT ?", (*Time)(nil)).Scan(&tm))
+	assert.Nil(t, tm)
+	assert.NoError(t, db.QueryRow("SELECT ?", Time{Hour: 3}).Scan(&tm))
+	assert.Equal(t, &Time{Hour: 3}, tm)
+
+	var dt *DateTime
+	assert.NoError(t, db.QueryRow("SELECT ?", (*DateTime)(nil)).Scan(&dt))
+	assert.Nil(t, dt)
+	want := DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876}}
+	assert.NoError(t, db.QueryRow("SELECT ?", &want).Scan(&dt))
+	assert.Equal(t, &want, dt)
+}
+
+func TestNull_JSON(t *testing.T) {
+	type Row struct {
+		D  NullDate     `json:"d"`
+		T  NullTime     `json:"t"`
+		DT NullDateTime `json:"dt"`
+	}
+	in := Row{D: NullDate{Date{2020, 2, 29}, true}, T: NullTime{Time{Hour: 9}, true}}
+	b, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"d":"2020-02-29","t":"09:00:00","dt":null}`, string(b))
+
+	out := Row{DT: NullDateTime{DateTime{Date{2021, 3, 4}, Time{}}, true}}
+	assert.NoError(t, json.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+}
+
+/* === ERRORS === */
+
+func TestNull_Errors(t *testing.T) {
+	n := NullDate{Date{2020, 2, 29}, true}
+	assert.Error(t, n.Scan("2020-02-30"))
+	assert.Equal(t, NullDate{Date{2020, 2, 29}, true}, n)
+	assert.Error(t, n.UnmarshalJSON([]byte(`"2020-02-30"`)))
+
+	var nt NullTime
+	assert.Error(t, nt.Scan(42))
+	assert.False(t, nt.Valid)
+
+	var ndt NullDateTime
+	assert.Error(t, ndt.UnmarshalJSON([]byte(`1`)))
+	assert.False(t, ndt.Valid)
+}
@@ -0,0 +1,49 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+)
+
+// oracleLayouts are Oracle's default NLS_DATE_FORMAT, DD-MON-RR, and
+// NLS_TIMESTAMP_FORMAT, DD-MON-RR HH.MI.SSXFF AM, with the variants that
+// have four-digit years or a 24-hour time, as time.Parse layouts.
+var oracleLayouts = []string{
+	"02-Jan-06 03.04.05.999999999 PM",
+	"02-Jan-2006 03.04.05.999999999 PM",
+	"02-Jan-06 15:04:05",
+	"02-Jan-2006 15:04:05",
+	"02-Jan-06",
+	"02-Jan-2006",
+}
+
+// parseOracle parses s as a datetime with either separator, as a date
+// alone, or in one of oracleLayouts.
+func (o ParseOptions) parseOracle(s string) (DateTime, error) {
+	o.SpaceSeparator = true
+	if dt, err := o.ParseDateTime(s); err == nil {
+		return dt, nil
+	}
+	if d, err := o.ParseDate(s); err == nil {
+		return DateTime{Date: d}, nil
+	}
+	for _, layout := range oracleLayouts {
+		if dt, err := o.ParseDateTimeLayout(layout, s); err == nil {
+			return dt, nil
+		}
+	}
+	return DateTime{}, fmt.Errorf("%w: %q is not an Oracle date or timestamp", ErrInvalidFormat, s)
+}
@@ -0,0 +1,85 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialectOracle_Scan(t *testing.T) {
+	opts := SQLOptions{Dialect: DialectOracle}
+
+	type TC struct {
+		In  interface{}
+		Out DateTime
+	}
+	tcs := []TC{
+		TC{In: "29-FEB-20", Out: DateTime{Date: Date{2020, 2, 29}}},
+		TC{In: "29-Feb-2020", Out: DateTime{Date: Date{2020, 2, 29}}},
+		TC{In: "29-FEB-20 03.42.31.876543000 PM", Out: DateTime{Date{2020, 2, 29}, Time{15, 42, 31, 876543000}}},
+		TC{In: []byte("29-FEB-2020 03.42.31.876543 AM"), Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876543000}}},
+		TC{In: "29-FEB-20 15:42:31", Out: DateTime{Date{2020, 2, 29}, Time{15, 42, 31, 0}}},
+		TC{In: "2020-02-29 15:42:31", Out: DateTime{Date{2020, 2, 29}, Time{15, 42, 31, 0}}},
+		TC{In: "2020-02-29T15:42:31.5", Out: DateTime{Date{2020, 2, 29}, Time{15, 42, 31, 500000000}}},
+		TC{In: "2020-02-29", Out: DateTime{Date: Date{2020, 2, 29}}},
+		TC{In: time.Date(2020, 2, 29, 15, 42, 31, 0, time.FixedZone("", -8*60*60)), Out: DateTime{Date{2020, 2, 29}, Time{15, 42, 31, 0}}},
+	}
+	for _, tc := range tcs {
+		var dt DateTime
+		assert.NoError(t, opts.Column(&dt).Scan(tc.In), tc.In)
+		assert.Equal(t, tc.Out, dt, tc.In)
+
+		// A DATE column scanned into a Date keeps only the date.
+		var d Date
+		assert.NoError(t, opts.Column(&d).Scan(tc.In), tc.In)
+		assert.Equal(t, tc.Out.Date, d, tc.In)
+	}
+}
+
+func TestDialectOracle_TwoDigitYearStart(t *testing.T) {
+	opts := SQLOptions{Dialect: DialectOracle}
+
+	var d Date
+	assert.NoError(t, opts.Column(&d).Scan("01-JAN-50"))
+	assert.Equal(t, Date{2050, 1, 1}, d)
+
+	opts.Parse.TwoDigitYearStart = 1950
+	assert.NoError(t, opts.Column(&d).Scan("01-JAN-50"))
+	assert.Equal(t, Date{1950, 1, 1}, d)
+}
+
+/* === ERRORS === */
+
+func TestDialectOracle_Errors(t *testing.T) {
+	// Oracle formats are only accepted under DialectOracle.
+	var dt DateTime
+	assert.Error(t, dt.Scan("29-FEB-20"))
+	var d Date
+	assert.Error(t, d.Scan("2020-02-29 15:42:31"))
+
+	opts := SQLOptions{Dialect: DialectOracle}
+	for _, s := range []string{"", "30-FEB-20", "29-FOO-20", "29-FEB-20 13.42.31 PM"} {
+		err := opts.Column(&dt).Scan(s)
+		assert.True(t, errors.Is(err, ErrInvalidFormat), s)
+		assert.True(t, errors.Is(opts.Column(&d).Scan(s), ErrInvalidFormat), s)
+	}
+	assert.EqualError(t, opts.Column(&dt).Scan("29-FOO-20"), `civil: cannot scan string into DateTime, want "2006-01-02T15:04:05.999999999" or an Oracle NLS format: civil: invalid format: "29-FOO-20" is not an Oracle date or timestamp`)
+	assert.Equal(t, DateTime{}, dt)
+	assert.Equal(t, Date{}, d)
+}
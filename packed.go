@@ -0,0 +1,57 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// Int returns the date packed into a decimal integer of the form YYYYMMDD,
+// such as 20200229, as stored by many legacy databases and mainframe
+// extracts. It assumes a valid date with a year in [0,9999].
+func (d Date) Int() int {
+	return d.Year*10000 + int(d.Month)*100 + d.Day
+}
+
+// DateFromInt returns the date of a decimal integer of the form YYYYMMDD, as
+// returned by Date.Int. It returns an error as for NewDate if the fields do
+// not form a valid date, which includes the 0 that such columns often hold
+// for a missing date, and an error wrapping ErrInvalidFormat if n is
+// negative.
+func DateFromInt(n int) (Date, error) {
+	if n < 0 {
+		return Date{}, fmt.Errorf("%w: %d is not a date of the form YYYYMMDD", ErrInvalidFormat, n)
+	}
+	return NewDate(n/10000, time.Month(n/100%100), n%100)
+}
+
+// Int returns the time of day packed into a decimal integer of the form
+// HHMMSS, such as 130500 for 13:05:00 or 34231 for 03:42:31. Fractions of a
+// second are dropped.
+func (t Time) Int() int {
+	return t.Hour*10000 + t.Minute*100 + t.Second
+}
+
+// TimeFromInt returns the time of day of a decimal integer of the form
+// HHMMSS, as returned by Time.Int, where the hour may have one digit or
+// none. It returns an error as for NewTime if the fields are out of range,
+// and an error wrapping ErrInvalidFormat if n is negative.
+func TimeFromInt(n int) (Time, error) {
+	if n < 0 {
+		return Time{}, fmt.Errorf("%w: %d is not a time of the form HHMMSS", ErrInvalidFormat, n)
+	}
+	return NewTime(n/10000, n/100%100, n%100, 0)
+}
@@ -0,0 +1,104 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_Int(t *testing.T) {
+	type TC struct {
+		In  Date
+		Out int
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 2, 29}, Out: 20200229},
+		TC{In: Date{1999, 12, 31}, Out: 19991231},
+		TC{In: Date{987, 1, 2}, Out: 9870102},
+		TC{In: Date{0, 1, 1}, Out: 101},
+		TC{In: Date{9999, 12, 31}, Out: 99991231},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.Int(), "%v", tc.In)
+
+		d, err := DateFromInt(tc.Out)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.In, d, "%d", tc.Out)
+	}
+}
+
+func TestTime_Int(t *testing.T) {
+	type TC struct {
+		In  Time
+		Out int
+	}
+	tcs := []TC{
+		TC{In: Time{3, 42, 31, 0}, Out: 34231},
+		TC{In: Time{13, 5, 0, 0}, Out: 130500},
+		TC{In: Time{0, 0, 7, 0}, Out: 7},
+		TC{In: Time{23, 59, 59, 0}, Out: 235959},
+		TC{In: Time{}, Out: 0},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.Int(), "%v", tc.In)
+
+		tm, err := TimeFromInt(tc.Out)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.In, tm, "%d", tc.Out)
+	}
+
+	assert.Equal(t, 34231, Time{3, 42, 31, 999999999}.Int())
+}
+
+/* === ERRORS === */
+
+func TestDateFromInt_Errors(t *testing.T) {
+	type TC struct {
+		In  int
+		Err error
+	}
+	tcs := []TC{
+		TC{In: 0, Err: ErrInvalidMonth},
+		TC{In: 20201301, Err: ErrInvalidMonth},
+		TC{In: 20210229, Err: ErrInvalidDay},
+		TC{In: 20200400, Err: ErrInvalidDay},
+		TC{In: 100000101, Err: ErrYearOutOfRange},
+		TC{In: -20200229, Err: ErrInvalidFormat},
+	}
+	for _, tc := range tcs {
+		_, err := DateFromInt(tc.In)
+		assert.True(t, errors.Is(err, tc.Err), "%d: %v", tc.In, err)
+	}
+}
+
+func TestTimeFromInt_Errors(t *testing.T) {
+	type TC struct {
+		In  int
+		Err error
+	}
+	tcs := []TC{
+		TC{In: 250000, Err: ErrInvalidTime},
+		TC{In: 126000, Err: ErrInvalidTime},
+		TC{In: 125961, Err: ErrInvalidTime},
+		TC{In: -1, Err: ErrInvalidFormat},
+	}
+	for _, tc := range tcs {
+		_, err := TimeFromInt(tc.In)
+		assert.True(t, errors.Is(err, tc.Err), "%d: %v", tc.In, err)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// The Parquet helpers convert civil values to and from the physical values
+// of the Parquet logical types, as written with parquet-go:
+//
+//	DATE                               INT32: days since 1970-01-01
+//	TIME(isAdjustedToUTC=false)        INT32 or INT64: units since midnight
+//	TIMESTAMP(isAdjustedToUTC=false)   INT64: units since 1970-01-01T00:00:00
+//
+// The unit of TIME and TIMESTAMP columns is given by a ParquetTimeUnit.
+// Fractions of a second finer than the unit are truncated toward the past.
+// The conversions to integers assume valid values. A ParquetTimeUnit other
+// than the defined constants is an error wrapping ErrInvalidUnit.
+
+// ParquetTimeUnit is the unit of a Parquet TIME or TIMESTAMP column.
+type ParquetTimeUnit int
+
+const (
+	// ParquetMillis counts milliseconds. TIME columns in milliseconds are
+	// stored as INT32.
+	ParquetMillis ParquetTimeUnit = iota
+	// ParquetMicros counts microseconds.
+	ParquetMicros
+	// ParquetNanos counts nanoseconds. A TIMESTAMP in nanoseconds can only
+	// represent datetimes from 1677 to 2262.
+	ParquetNanos
+)
+
+// duration returns the length of the unit, or an error wrapping
+// ErrInvalidUnit if u is not one of the defined constants.
+func (u ParquetTimeUnit) duration() (time.Duration, error) {
+	switch u {
+	case ParquetMillis:
+		return time.Millisecond, nil
+	case ParquetMicros:
+		return time.Microsecond, nil
+	case ParquetNanos:
+		return time.Nanosecond, nil
+	}
+	return 0, fmt.Errorf("%w: %d is not a ParquetTimeUnit", ErrInvalidUnit, int(u))
+}
+
+// ParquetDate returns d as a Parquet DATE, the number of days since
+// 1970-01-01.
+func (d Date) ParquetDate() int32 {
+	return d.AvroDate()
+}
+
+// DateFromParquet returns the date of a Parquet DATE.
+func DateFromParquet(days int32) Date {
+	return DateFromAvro(days)
+}
+
+// ParquetTime returns t as a Parquet TIME in the given unit, the number of
+// units since midnight.
+func (t Time) ParquetTime(unit ParquetTimeUnit) (int64, error) {
+	d, err := unit.duration()
+	if err != nil {
+		return 0, err
+	}
+	return int64(t.sinceMidnight() / d), nil
+}
+
+// TimeFromParquet returns the time of a Parquet TIME in the given unit. It
+// returns an error wrapping ErrInvalidTime if v is negative or more than a
+// day; a whole day is the end-of-day time 24:00.
+func TimeFromParquet(v int64, unit ParquetTimeUnit) (Time, error) {
+	d, err := unit.duration()
+	if err != nil {
+		return Time{}, err
+	}
+	if v < 0 || v > int64(24*time.Hour/d) {
+		return Time{}, fmt.Errorf("%w: %d is not in a day of %v units", ErrInvalidTime, v, d)
+	}
+	return timeSinceMidnight(time.Duration(v) * d)
+}
+
+// ParquetTimestamp returns dt as a Parquet TIMESTAMP with
+// isAdjustedToUTC=false in the given unit, the number of units since
+// 1970-01-01T00:00:00. It returns an error wrapping ErrYearOutOfRange if the
+// result does not fit in an int64, as happens in nanoseconds outside the
+// years 1677 to 2262.
+func (dt DateTime) ParquetTimestamp(unit ParquetTimeUnit) (int64, error) {
+	d, err := unit.duration()
+	if err != nil {
+		return 0, err
+	}
+	v, ok := dt.localTimestamp(d)
+	if !ok {
+		return 0, fmt.Errorf("%w: %v does not fit in a timestamp of %v units", ErrYearOutOfRange, dt, d)
+	}
+	return v, nil
+}
+
+// DateTimeFromParquetTimestamp returns the datetime of a Parquet TIMESTAMP
+// with isAdjustedToUTC=false in the given unit.
+func DateTimeFromParquetTimestamp(v int64, unit ParquetTimeUnit) (DateTime, error) {
+	d, err := unit.duration()
+	if err != nil {
+		return DateTime{}, err
+	}
+	return dateTimeFromLocalTimestamp(v, d), nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParquet_Date(t *testing.T) {
+	d := Date{2020, 2, 29}
+	assert.Equal(t, int32(18321), d.ParquetDate())
+	assert.Equal(t, d, DateFromParquet(18321))
+}
+
+func TestParquet_Time(t *testing.T) {
+	type TC struct {
+		Unit ParquetTimeUnit
+		In   Time
+		Out  int64
+		Back Time
+	}
+	tm := Time{3, 42, 31, 876543219}
+	tcs := []TC{
+		TC{Unit: ParquetMillis, In: tm, Out: 13351876, Back: Time{3, 42, 31, 876000000}},
+		TC{Unit: ParquetMicros, In: tm, Out: 13351876543, Back: Time{3, 42, 31, 876543000}},
+		TC{Unit: ParquetNanos, In: tm, Out: 13351876543219, Back: tm},
+		TC{Unit: ParquetMicros, In: Time{24, 0, 0, 0}, Out: 86400000000, Back: Time{24, 0, 0, 0}},
+	}
+	for _, tc := range tcs {
+		v, err := tc.In.ParquetTime(tc.Unit)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.Out, v, tc.In)
+		back, err := TimeFromParquet(tc.Out, tc.Unit)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.Back, back, tc.Out)
+	}
+}
+
+func TestParquet_Timestamp(t *testing.T) {
+	type TC struct {
+		Unit ParquetTimeUnit
+		In   DateTime
+		Out  int64
+	}
+	tcs := []TC{
+		TC{Unit: ParquetMillis, In: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876000000}}, Out: 1582947751876},
+		TC{Unit: ParquetMicros, In: DateTime{Date{1969, 12, 31}, Time{23, 59, 59, 999999000}}, Out: -1},
+		TC{Unit: ParquetNanos, In: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876543219}}, Out: 1582947751876543219},
+		TC{Unit: ParquetMillis, In: DateTime{Date{9999, 12, 31}, Time{23, 59, 59, 999000000}}, Out: 253402300799999},
+	}
+	for _, tc := range tcs {
+		v, err := tc.In.ParquetTimestamp(tc.Unit)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.Out, v, tc.In)
+		dt, err := DateTimeFromParquetTimestamp(tc.Out, tc.Unit)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.In, dt, tc.Out)
+	}
+}
+
+/* === ERRORS === */
+
+func TestParquet_Errors(t *testing.T) {
+	for _, v := range []int64{-1, 86400000001} {
+		_, err := TimeFromParquet(v, ParquetMillis)
+		assert.True(t, errors.Is(err, ErrInvalidTime), v)
+	}
+
+	for _, dt := range []DateTime{
+		DateTime{Date{2262, 4, 12}, Time{0, 0, 0, 0}},
+		DateTime{Date{1677, 9, 21}, Time{0, 0, 0, 0}},
+		DateTime{Date{9999, 12, 31}, Time{0, 0, 0, 0}},
+	} {
+		_, err := dt.ParquetTimestamp(ParquetNanos)
+		assert.True(t, errors.Is(err, ErrYearOutOfRange), dt)
+	}
+	_, err := DateTime{Date{2262, 4, 11}, Time{0, 0, 0, 0}}.ParquetTimestamp(ParquetNanos)
+	assert.NoError(t, err)
+
+	for _, unit := range []ParquetTimeUnit{-1, 3} {
+		_, err = Time{3, 42, 31, 0}.ParquetTime(unit)
+		assert.True(t, errors.Is(err, ErrInvalidUnit), unit)
+		_, err = TimeFromParquet(0, unit)
+		assert.True(t, errors.Is(err, ErrInvalidUnit), unit)
+		_, err = DateTime{}.ParquetTimestamp(unit)
+		assert.True(t, errors.Is(err, ErrInvalidUnit), unit)
+		_, err = DateTimeFromParquetTimestamp(0, unit)
+		assert.True(t, errors.Is(err, ErrInvalidUnit), unit)
+	}
+	assert.EqualError(t, err, "civil: invalid unit: 3 is not a ParquetTimeUnit")
+}
@@ -0,0 +1,552 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseOptions configures optional relaxations of the RFC3339 based formats
+// accepted by ParseDate, ParseTime and ParseDateTime. The zero value accepts
+// exactly what those functions accept.
+//
+// The UnmarshalText, UnmarshalJSON and Scan methods of Date, Time and
+// DateTime, and the other decoders of the package, parse strings with the
+// zero value. Target and SQLOptions.Parse apply other options to a single
+// value or column.
+type ParseOptions struct {
+	// Lenient accepts month, day, hour, minute and second fields written
+	// without zero padding, as in "2020-3-4", "3:7:09" and "3:7". The year must
+	// still have four digits.
+	Lenient bool
+
+	// TwoDigitYearStart is the first year of the 100-year window into which
+	// two-digit years ("06" in a layout) are placed by ParseDateLayout and
+	// ParseDateTimeLayout. For example, with 1950 the value "49" means 2049
+	// and "50" means 1950. Zero selects the window used by time.Parse,
+	// which starts at 1969.
+	TwoDigitYearStart int
+
+	// SpaceSeparator also accepts a space between the date and the time of a
+	// DateTime, as in "2020-02-29 03:42:31". A 'T' or 't' is always accepted.
+	SpaceSeparator bool
+
+	// Offset selects how ParseDateTime handles a trailing RFC 3339 time
+	// offset such as "Z" or "+02:00". The zero value rejects it.
+	Offset OffsetPolicy
+
+	// EndOfDay selects how the ISO 8601 end-of-day time "24:00:00" is
+	// handled. The zero value rejects it.
+	EndOfDay EndOfDayPolicy
+
+	// LeapSecond selects how a leap second, a seconds field of 60 as in
+	// "23:59:60", is handled. The zero value rejects it.
+	LeapSecond LeapSecondPolicy
+
+	// ExpandedYears accepts years in the ISO 8601 expanded representation
+	// written by Date.ExpandedString: a '+' or '-' followed by four to nine
+	// digits, as in "+12020-03-04" and "-0044-03-15". Unsigned years must
+	// still have exactly four digits.
+	ExpandedYears bool
+
+	// Strict rejects the zero date "0000-00-00", which ParseDate otherwise
+	// accepts as a placeholder for Date{}.
+	Strict bool
+}
+
+// EndOfDayPolicy selects how the end-of-day time 24:00 is parsed.
+type EndOfDayPolicy int
+
+const (
+	// EndOfDayReject reports an error for an hour of 24.
+	EndOfDayReject EndOfDayPolicy = iota
+
+	// EndOfDayKeep parses "24:00", "24:00:00" and "24:00:00.000…" as
+	// Time{Hour: 24}, for which IsEndOfDay reports true.
+	EndOfDayKeep
+
+	// EndOfDayNormalize is like EndOfDayKeep for a Time, but a DateTime at
+	// 24:00 is converted to 00:00 on the next day, so "2020-02-29T24:00"
+	// parses as 2020-03-01T00:00:00.
+	EndOfDayNormalize
+)
+
+// OffsetPolicy selects how a UTC offset following a DateTime is handled.
+type OffsetPolicy int
+
+const (
+	// OffsetReject reports an error for a DateTime with a UTC offset.
+	OffsetReject OffsetPolicy = iota
+
+	// OffsetStrip discards the offset and keeps the wall-clock time as
+	// written, so "2020-02-29T03:42:31+02:00" parses as 03:42:31.
+	OffsetStrip
+
+	// OffsetToUTC converts the time to the UTC wall-clock time, so
+	// "2020-02-29T03:42:31+02:00" parses as 01:42:31.
+	OffsetToUTC
+)
+
+// decoding returns o as used to decode values: with ExpandedYears set if
+// MinYear and MaxYear allow years outside [0,9999], so that the
+// unmarshalers read what the marshalers write.
+func (o ParseOptions) decoding() ParseOptions {
+	if MinYear < 0 || MaxYear > 9999 {
+		o.ExpandedYears = true
+	}
+	return o
+}
+
+// decodeOptions returns the options used by the decoders of the package.
+func decodeOptions() ParseOptions {
+	return ParseOptions{}.decoding()
+}
+
+// Target returns an adapter that decodes text, JSON and XML into v, which
+// must be a *Date, *Time or *DateTime, parsing strings with o rather than
+// as the methods of v do:
+//
+//	opts := civil.ParseOptions{SpaceSeparator: true}
+//	err := json.Unmarshal(data, opts.Target(&dt))
+func (o ParseOptions) Target(v interface{}) *ParseTarget {
+	return &ParseTarget{v: v, opts: o}
+}
+
+// ParseTarget is an encoding.TextUnmarshaler, json.Unmarshaler and
+// xml.Unmarshaler, returned by ParseOptions.Target, that decodes into a
+// civil value with a set of ParseOptions.
+type ParseTarget struct {
+	v    interface{}
+	opts ParseOptions
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *ParseTarget) UnmarshalText(data []byte) error {
+	switch v := t.v.(type) {
+	case *Date:
+		return v.unmarshalText(t.opts, data)
+	case *Time:
+		return v.unmarshalText(t.opts, data)
+	case *DateTime:
+		return v.unmarshalText(t.opts, data)
+	}
+	return t.unsupported()
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves the target unchanged.
+func (t *ParseTarget) UnmarshalJSON(data []byte) error {
+	switch v := t.v.(type) {
+	case *Date:
+		return v.unmarshalJSON(t.opts, data)
+	case *Time:
+		return v.unmarshalJSON(t.opts, data)
+	case *DateTime:
+		return v.unmarshalJSON(t.opts, data)
+	}
+	return t.unsupported()
+}
+
+func (t *ParseTarget) unsupported() error {
+	return fmt.Errorf("%w: cannot decode into %T", ErrUnsupportedType, t.v)
+}
+
+// ParseDate is like the package-level ParseDate but applies the options.
+func (o ParseOptions) ParseDate(s string) (Date, error) {
+	sc := &scanner{in: s, end: len(s)}
+	d, err := o.scanDate(sc)
+	if err != nil {
+		return Date{}, err
+	}
+	if err := sc.done(ComponentDay); err != nil {
+		return Date{}, err
+	}
+	return d, nil
+}
+
+// LeapSecondPolicy selects how a seconds field of 60 is parsed.
+type LeapSecondPolicy int
+
+const (
+	// LeapSecondReject reports an error for a seconds field of 60.
+	LeapSecondReject LeapSecondPolicy = iota
+
+	// LeapSecondClamp replaces the leap second with the last instant of the
+	// preceding second, so "23:59:60" parses as 23:59:59.999999999.
+	LeapSecondClamp
+
+	// LeapSecondCarry carries the leap second into the next minute, so
+	// "12:30:60.5" parses as 12:31:00.5. A Time at 23:59:60 wraps to
+	// 00:00:00 and a DateTime moves to the next day.
+	LeapSecondCarry
+)
+
+// ParseTime is like the package-level ParseTime but applies the options.
+func (o ParseOptions) ParseTime(s string) (Time, error) {
+	t, _, err := o.scanTime(&scanner{in: s, end: len(s)})
+	return t, err
+}
+
+// ParseDateTime is like the package-level ParseDateTime but applies the
+// options. The date and time parts are parsed as by o.ParseDate and
+// o.ParseTime.
+func (o ParseOptions) ParseDateTime(s string) (DateTime, error) {
+	dt, loc, err := o.parseDateTime(s, o.Offset != OffsetReject)
+	if err != nil {
+		return DateTime{}, err
+	}
+	if loc != nil && o.Offset == OffsetToUTC {
+		dt = DateTimeOf(dt.In(loc).In(time.UTC))
+	}
+	return dt, nil
+}
+
+// ParseDateTimeOffset is like ParseDateTime but accepts a trailing RFC 3339
+// time offset whatever the Offset policy, and returns it as a fixed zone
+// alongside the wall-clock time as written. The zone is time.UTC for "Z" and
+// nil if there is no offset. dt.In(loc) gives the instant that was written.
+func (o ParseOptions) ParseDateTimeOffset(s string) (dt DateTime, loc *time.Location, err error) {
+	return o.parseDateTime(s, true)
+}
+
+func (o ParseOptions) parseDateTime(s string, allowOffset bool) (DateTime, *time.Location, error) {
+	sc := &scanner{in: s, end: len(s)}
+	d, err := o.scanDate(sc)
+	if err != nil {
+		return DateTime{}, nil, err
+	}
+	switch c := sc.peek(); {
+	case c == 'T' || c == 't' || (c == ' ' && o.SpaceSeparator):
+		sc.pos++
+	case o.SpaceSeparator:
+		return DateTime{}, nil, sc.fail(ErrInvalidFormat, ComponentSeparator, sc.pos, "expected 'T' or ' ' between date and time")
+	default:
+		return DateTime{}, nil, sc.fail(ErrInvalidFormat, ComponentSeparator, sc.pos, "expected 'T' between date and time")
+	}
+
+	clock, loc, ok := splitOffset(s[sc.pos:])
+	if at := sc.pos + len(clock); !ok {
+		return DateTime{}, nil, sc.fail(ErrInvalidOffset, ComponentOffset, at, "invalid UTC offset %q", s[at:])
+	} else if loc != nil && !allowOffset {
+		return DateTime{}, nil, sc.fail(ErrInvalidOffset, ComponentOffset, at, "unexpected UTC offset %q", s[at:])
+	}
+	sc.end = sc.pos + len(clock)
+
+	t, carry, err := o.scanTime(sc)
+	if err != nil {
+		return DateTime{}, nil, err
+	}
+	if carry != 0 {
+		d = d.AddDays(carry)
+	}
+	if o.EndOfDay == EndOfDayNormalize && t.IsEndOfDay() {
+		d, t = d.AddDays(1), Time{}
+	}
+	return DateTime{Date: d, Time: t}, loc, nil
+}
+
+// splitOffset splits a trailing time offset from a time of day. The offset
+// may be "Z" or a numeric offset of the form ±HH:MM, ±HHMM or ±HH. The
+// returned zone is nil if s has no offset, and ok is false if the text from
+// the last '+' or '-' is not a valid offset.
+func splitOffset(s string) (clock string, loc *time.Location, ok bool) {
+	if n := len(s); n > 0 && (s[n-1] == 'Z' || s[n-1] == 'z') {
+		return s[:n-1], time.UTC, true
+	}
+	i := strings.LastIndexAny(s, "+-")
+	if i < 0 {
+		return s, nil, true
+	}
+
+	offset := s[i:]
+	var hh, mm string
+	switch {
+	case len(offset) == len("+07:00") && offset[3] == ':':
+		hh, mm = offset[1:3], offset[4:]
+	case len(offset) == len("+0700"):
+		hh, mm = offset[1:3], offset[3:]
+	case len(offset) == len("+07"):
+		hh, mm = offset[1:3], "00"
+	default:
+		return s[:i], nil, false
+	}
+	h, herr := parseDigits(hh)
+	m, merr := parseDigits(mm)
+	if herr != nil || merr != nil || h > 23 || m > 59 {
+		return s[:i], nil, false
+	}
+
+	secs := (h*60 + m) * 60
+	if offset[0] == '-' {
+		secs = -secs
+	}
+	return s[:i], time.FixedZone("", secs), true
+}
+
+// scanDate scans a date of the form YYYY-MM-DD.
+func (o ParseOptions) scanDate(sc *scanner) (Date, error) {
+	const dateZero = "0000-00-00"
+
+	if !o.Strict && strings.HasPrefix(sc.in[sc.pos:sc.end], dateZero) {
+		sc.pos += len(dateZero)
+		return Date{}, nil
+	}
+	width := o.minWidth()
+	year, err := o.scanYear(sc)
+	if err != nil {
+		return Date{}, err
+	}
+	if err := sc.literal('-', ComponentMonth); err != nil {
+		return Date{}, err
+	}
+	monthAt := sc.pos
+	month, err := sc.number(ComponentMonth, width, 2)
+	if err != nil {
+		return Date{}, err
+	}
+	if err := sc.literal('-', ComponentDay); err != nil {
+		return Date{}, err
+	}
+	dayAt := sc.pos
+	day, err := sc.number(ComponentDay, width, 2)
+	if err != nil {
+		return Date{}, err
+	}
+
+	if month < 1 || month > 12 {
+		return Date{}, sc.fail(ErrInvalidMonth, ComponentMonth, monthAt, "month %d out of range [1,12]", month)
+	}
+	if n := daysIn(time.Month(month), year); day < 1 || day > n {
+		return Date{}, sc.fail(ErrInvalidDay, ComponentDay, dayAt, "day %d out of range [1,%d] for %s %d", day, n, time.Month(month), year)
+	}
+	return Date{Year: year, Month: time.Month(month), Day: day}, nil
+}
+
+// scanYear scans a four-digit year or, with ExpandedYears, a signed year.
+func (o ParseOptions) scanYear(sc *scanner) (int, error) {
+	sign := sc.peek()
+	if !o.ExpandedYears || (sign != '+' && sign != '-') {
+		return sc.number(ComponentYear, 4, 4)
+	}
+	sc.pos++
+	year, err := sc.number(ComponentYear, 4, 9)
+	if sign == '-' {
+		year = -year
+	}
+	return year, err
+}
+
+// scanTime scans the rest of the input as a time of the form
+// HH:MM[:SS[.fffffffff]]. It also returns the number of days carried by a
+// leap second at the end of the day.
+func (o ParseOptions) scanTime(sc *scanner) (Time, int, error) {
+	width := o.minWidth()
+	hourAt := sc.pos
+	hour, err := sc.number(ComponentHour, 1, 2)
+	if err != nil {
+		return Time{}, 0, err
+	}
+	if err := sc.literal(':', ComponentMinute); err != nil {
+		return Time{}, 0, err
+	}
+	minuteAt := sc.pos
+	minute, err := sc.number(ComponentMinute, width, 2)
+	if err != nil {
+		return Time{}, 0, err
+	}
+
+	last, secondAt := ComponentMinute, sc.pos
+	var second, nanos int
+	if sc.peek() == ':' {
+		sc.pos++
+		secondAt = sc.pos
+		if second, err = sc.number(ComponentSecond, width, 2); err != nil {
+			return Time{}, 0, err
+		}
+		last = ComponentSecond
+		if c := sc.peek(); c == '.' || c == ',' {
+			sc.pos++
+			if nanos, err = sc.fraction(); err != nil {
+				return Time{}, 0, err
+			}
+			last = ComponentFraction
+		}
+	}
+	if err := sc.done(last); err != nil {
+		return Time{}, 0, err
+	}
+
+	switch {
+	case hour == 24 && minute == 0 && second == 0 && nanos == 0 && o.EndOfDay != EndOfDayReject:
+		return Time{Hour: 24}, 0, nil
+	case hour > 23:
+		return Time{}, 0, sc.fail(ErrInvalidTime, ComponentHour, hourAt, "hour %d out of range [0,23]", hour)
+	case minute > 59:
+		return Time{}, 0, sc.fail(ErrInvalidTime, ComponentMinute, minuteAt, "minute %d out of range [0,59]", minute)
+	case second == 60 && o.LeapSecond == LeapSecondClamp:
+		return Time{Hour: hour, Minute: minute, Second: 59, Nanosecond: 999999999}, 0, nil
+	case second == 60 && o.LeapSecond == LeapSecondCarry:
+		t := Time{Hour: hour, Minute: minute, Second: 59, Nanosecond: nanos}
+		carried := t.onDate0().Add(time.Second)
+		return TimeOf(carried), carried.Day() - 1, nil
+	case second > 59:
+		return Time{}, 0, sc.fail(ErrInvalidTime, ComponentSecond, secondAt, "second %d out of range [0,59]", second)
+	}
+	return Time{Hour: hour, Minute: minute, Second: second, Nanosecond: nanos}, 0, nil
+}
+
+// minWidth returns the minimum number of digits in a month, day, hour,
+// minute or second field.
+func (o ParseOptions) minWidth() int {
+	if o.Lenient {
+		return 1
+	}
+	return 2
+}
+
+// A scanner reads the fields of a date or time from in[pos:end], reporting
+// problems as a *ParseError against the whole of in.
+type scanner struct {
+	in  string
+	pos int
+	end int
+}
+
+// fail returns a *ParseError wrapping err, one of the sentinel errors.
+func (sc *scanner) fail(err error, c Component, offset int, format string, args ...interface{}) error {
+	return &ParseError{Input: sc.in, Offset: offset, Component: c, Message: fmt.Sprintf(format, args...), Err: err}
+}
+
+// peek returns the next byte, or 0 at the end of the input.
+func (sc *scanner) peek() byte {
+	if sc.pos < sc.end {
+		return sc.in[sc.pos]
+	}
+	return 0
+}
+
+// number scans at least min and at most max decimal digits of the field c.
+func (sc *scanner) number(c Component, min, max int) (int, error) {
+	start, n := sc.pos, 0
+	for sc.pos < sc.end && sc.pos-start < max && isASCIIDigit(sc.in[sc.pos]) {
+		n = n*10 + int(sc.in[sc.pos]-'0')
+		sc.pos++
+	}
+	switch {
+	case sc.pos-start >= min:
+		return n, nil
+	case min == max:
+		return 0, sc.fail(ErrInvalidFormat, c, start, "expected %d digits for %s", min, c)
+	case min+1 == max:
+		return 0, sc.fail(ErrInvalidFormat, c, start, "expected %d or %d digits for %s", min, max, c)
+	default:
+		return 0, sc.fail(ErrInvalidFormat, c, start, "expected %d to %d digits for %s", min, max, c)
+	}
+}
+
+// fraction scans the one to nine digits of a fractional second and returns
+// their value in nanoseconds.
+func (sc *scanner) fraction() (int, error) {
+	start, nanos := sc.pos, 0
+	for ; sc.pos < sc.end && isASCIIDigit(sc.in[sc.pos]); sc.pos++ {
+		if sc.pos-start == 9 {
+			return 0, sc.fail(ErrInvalidFormat, ComponentFraction, start, "fractional second has more than nine digits")
+		}
+		nanos = nanos*10 + int(sc.in[sc.pos]-'0')
+	}
+	if sc.pos == start {
+		return 0, sc.fail(ErrInvalidFormat, ComponentFraction, start, "expected digits after the decimal separator")
+	}
+	for n := sc.pos - start; n < 9; n++ {
+		nanos *= 10
+	}
+	return nanos, nil
+}
+
+// literal scans the byte b, which precedes the field c.
+func (sc *scanner) literal(b byte, c Component) error {
+	if sc.pos >= sc.end || sc.in[sc.pos] != b {
+		return sc.fail(ErrInvalidFormat, c, sc.pos, "expected %q before %s", b, c)
+	}
+	sc.pos++
+	return nil
+}
+
+// done reports an error if any input follows the field c.
+func (sc *scanner) done(c Component) error {
+	if sc.pos < sc.end {
+		return sc.fail(ErrInvalidFormat, c, sc.pos, "unexpected text %q after %s", sc.in[sc.pos:sc.end], c)
+	}
+	return nil
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// ParseDateLayout is like the package-level ParseDateLayout but applies the
+// options.
+func (o ParseOptions) ParseDateLayout(layout, value string) (Date, error) {
+	d, err := ParseDateLayout(layout, value)
+	if err != nil {
+		return Date{}, err
+	}
+	return o.windowYear(d, layout, value)
+}
+
+// ParseDateTimeLayout is like the package-level ParseDateTimeLayout but
+// applies the options.
+func (o ParseOptions) ParseDateTimeLayout(layout, value string) (DateTime, error) {
+	dt, err := ParseDateTimeLayout(layout, value)
+	if err != nil {
+		return DateTime{}, err
+	}
+	dt.Date, err = o.windowYear(dt.Date, layout, value)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return dt, nil
+}
+
+// windowYear moves a year parsed from a two-digit layout element into the
+// window selected by TwoDigitYearStart.
+func (o ParseOptions) windowYear(d Date, layout, value string) (Date, error) {
+	if o.TwoDigitYearStart == 0 || !hasTwoDigitYear(layout) {
+		return d, nil
+	}
+	start := o.TwoDigitYearStart
+	d.Year = start + ((d.Year%100-start%100)%100+100)%100
+	if !d.IsValid() {
+		return Date{}, &ParseError{Input: value, Offset: len(value), Component: ComponentDay,
+			Message: fmt.Sprintf("day %d out of range for %s %d", d.Day, d.Month, d.Year), Err: ErrInvalidDay}
+	}
+	return d, nil
+}
+
+// hasTwoDigitYear reports whether layout contains the two-digit year element
+// "06" outside of the four-digit "2006".
+func hasTwoDigitYear(layout string) bool {
+	for i := 0; i < len(layout); i++ {
+		switch {
+		case strings.HasPrefix(layout[i:], "2006"):
+			i += 3
+		case strings.HasPrefix(layout[i:], "06"):
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,377 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOptions_Lenient(t *testing.T) {
+	strict := ParseOptions{}
+	lenient := ParseOptions{Lenient: true}
+
+	_, err := strict.ParseDate("2020-3-4")
+	assert.Error(t, err)
+	d, err := lenient.ParseDate("2020-3-4")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{Year: 2020, Month: 3, Day: 4}, d)
+	assert.Equal(t, "2020-03-04", d.String())
+	d, err = lenient.ParseDate("2020-03-04")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{Year: 2020, Month: 3, Day: 4}, d)
+	d, err = lenient.ParseDate("0000-00-00")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{}, d)
+	_, err = lenient.ParseDate("20-3-4")
+	assert.Error(t, err)
+	_, err = lenient.ParseDate("2021-2-30")
+	assert.Error(t, err)
+
+	_, err = strict.ParseTime("3:7:09")
+	assert.Error(t, err)
+	tm, err := lenient.ParseTime("3:7:09")
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 3, Minute: 7, Second: 9}, tm)
+	tm, err = lenient.ParseTime("3:7:9.5")
+	assert.NoError(t, err)
+	assert.Equal(t, Time{Hour: 3, Minute: 7, Second: 9, Nanosecond: 500000000}, tm)
+	_, err = lenient.ParseTime("3:7:9.1234567890")
+	assert.Error(t, err)
+
+	_, err = strict.ParseDateTime("2020-3-4T3:7:09")
+	assert.Error(t, err)
+	dt, err := lenient.ParseDateTime("2020-3-4T3:7:09")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date: Date{2020, 3, 4}, Time: Time{3, 7, 9, 0}}, dt)
+	dt, err = lenient.ParseDateTime("2020-3-4t3:7:09")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date: Date{2020, 3, 4}, Time: Time{3, 7, 9, 0}}, dt)
+}
+
+func TestParseOptions_TwoDigitYearStart(t *testing.T) {
+	type TC struct {
+		Start  int
+		Layout string
+		Value  string
+		Out    Date
+		HasErr bool
+	}
+	tcs := []TC{
+		TC{Start: 0, Layout: "06-01-02", Value: "24-03-05", Out: Date{2024, 3, 5}},
+		TC{Start: 0, Layout: "06-01-02", Value: "69-03-05", Out: Date{1969, 3, 5}},
+		TC{Start: 1900, Layout: "06-01-02", Value: "24-03-05", Out: Date{1924, 3, 5}},
+		TC{Start: 1970, Layout: "01/02/06", Value: "03/05/69", Out: Date{2069, 3, 5}},
+		TC{Start: 1970, Layout: "01/02/06", Value: "03/05/70", Out: Date{1970, 3, 5}},
+		TC{Start: 1950, Layout: "01/02/06", Value: "12/31/49", Out: Date{2049, 12, 31}},
+		TC{Start: 2000, Layout: "01/02/06", Value: "02/29/00", Out: Date{2000, 2, 29}},
+		TC{Start: 1900, Layout: "2006-01-02", Value: "2024-03-05", Out: Date{2024, 3, 5}},
+		/* === ERRORS === */
+		TC{Start: 1900, Layout: "01/02/06", Value: "02/29/00", HasErr: true},
+		TC{Start: 1900, Layout: "01/02/06", Value: "13/01/00", HasErr: true},
+	}
+	for _, tc := range tcs {
+		d, err := ParseOptions{TwoDigitYearStart: tc.Start}.ParseDateLayout(tc.Layout, tc.Value)
+		if tc.HasErr {
+			assert.Error(t, err, tc.Value)
+			continue
+		}
+		assert.NoError(t, err, tc.Value)
+		assert.Equal(t, tc.Out, d, tc.Value)
+	}
+
+	dt, err := ParseOptions{TwoDigitYearStart: 1900}.ParseDateTimeLayout("02.01.06 15:04", "05.03.24 13:45")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date: Date{1924, 3, 5}, Time: Time{13, 45, 0, 0}}, dt)
+}
+
+func TestParseOptions_SpaceSeparator(t *testing.T) {
+	want := DateTime{Date: Date{2020, 2, 29}, Time: Time{3, 42, 31, 0}}
+
+	_, err := ParseDateTime("2020-02-29 03:42:31")
+	assert.Error(t, err)
+
+	o := ParseOptions{SpaceSeparator: true}
+	for _, s := range []string{"2020-02-29 03:42:31", "2020-02-29T03:42:31", "2020-02-29t03:42:31"} {
+		dt, err := o.ParseDateTime(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, want, dt, s)
+	}
+
+	dt, err := ParseOptions{SpaceSeparator: true, Lenient: true}.ParseDateTime("2020-2-29 3:42:31")
+	assert.NoError(t, err)
+	assert.Equal(t, want, dt)
+
+	for _, s := range []string{"2020-02-29  03:42:31", "2020-02-29_03:42:31", "2020-02-29", " 03:42:31"} {
+		_, err := o.ParseDateTime(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestParseOptions_Target(t *testing.T) {
+	want := DateTime{Date: Date{2020, 2, 29}, Time: Time{3, 42, 31, 0}}
+
+	var dt DateTime
+	assert.Error(t, dt.UnmarshalJSON([]byte(`"2020-02-29 03:42:31"`)))
+
+	opts := ParseOptions{SpaceSeparator: true}
+
+	dt = DateTime{}
+	assert.NoError(t, json.Unmarshal([]byte(`"2020-02-29 03:42:31"`), opts.Target(&dt)))
+	assert.Equal(t, want, dt)
+
+	dt = DateTime{}
+	assert.NoError(t, opts.Target(&dt).UnmarshalText([]byte("2020-02-29 03:42:31")))
+	assert.Equal(t, want, dt)
+
+	dt = DateTime{}
+	assert.NoError(t, dt.Scan("2020-02-29 03:42:31"))
+	assert.Equal(t, want, dt)
+
+	opts = ParseOptions{Lenient: true}
+
+	var d Date
+	assert.NoError(t, json.Unmarshal([]byte(`"2020-2-9"`), opts.Target(&d)))
+	assert.Equal(t, Date{2020, 2, 9}, d)
+
+	var tm Time
+	assert.NoError(t, opts.Target(&tm).UnmarshalText([]byte("3:7:9")))
+	assert.Equal(t, Time{3, 7, 9, 0}, tm)
+
+	// The methods of the types themselves are unaffected.
+	assert.Error(t, d.UnmarshalJSON([]byte(`"2020-2-9"`)))
+}
+
+func TestParseOptions_Offset(t *testing.T) {
+	type TC struct {
+		In     string
+		Policy OffsetPolicy
+		Out    DateTime
+		HasErr bool
+	}
+	tcs := []TC{
+		TC{In: "2020-02-29T03:42:31", Policy: OffsetReject, Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}},
+		TC{In: "2020-02-29T03:42:31+02:00", Policy: OffsetStrip, Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}},
+		TC{In: "2020-02-29T03:42:31+02:00", Policy: OffsetToUTC, Out: DateTime{Date{2020, 2, 29}, Time{1, 42, 31, 0}}},
+		TC{In: "2020-02-29T01:42:31.5+02:00", Policy: OffsetToUTC, Out: DateTime{Date{2020, 2, 28}, Time{23, 42, 31, 500000000}}},
+		TC{In: "2020-02-29T23:42:31-0230", Policy: OffsetToUTC, Out: DateTime{Date{2020, 3, 1}, Time{2, 12, 31, 0}}},
+		TC{In: "2020-02-29T03:42:31-05", Policy: OffsetToUTC, Out: DateTime{Date{2020, 2, 29}, Time{8, 42, 31, 0}}},
+		TC{In: "2020-02-29T03:42:31Z", Policy: OffsetToUTC, Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}},
+		TC{In: "2020-02-29T03:42:31z", Policy: OffsetStrip, Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}},
+		/* === ERRORS === */
+		TC{In: "2020-02-29T03:42:31Z", Policy: OffsetReject, HasErr: true},
+		TC{In: "2020-02-29T03:42:31+02:00", Policy: OffsetReject, HasErr: true},
+		TC{In: "2020-02-29T03:42:31+24:00", Policy: OffsetStrip, HasErr: true},
+		TC{In: "2020-02-29T03:42:31+02:60", Policy: OffsetStrip, HasErr: true},
+		TC{In: "2020-02-29T03:42:31+2:00", Policy: OffsetStrip, HasErr: true},
+		TC{In: "2020-02-29T03:42:31+02:00Z", Policy: OffsetStrip, HasErr: true},
+		TC{In: "2020-02-29T00:-1:00", Policy: OffsetStrip, HasErr: true},
+	}
+	for _, tc := range tcs {
+		dt, err := ParseOptions{Offset: tc.Policy}.ParseDateTime(tc.In)
+		if tc.HasErr {
+			assert.Error(t, err, tc.In)
+			continue
+		}
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, dt, tc.In)
+	}
+}
+
+func TestParseOptions_ParseDateTimeOffset(t *testing.T) {
+	dt, loc, err := ParseOptions{}.ParseDateTimeOffset("2020-02-29T03:42:31+02:00")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}, dt)
+	_, offset := dt.In(loc).Zone()
+	assert.Equal(t, 2*60*60, offset)
+	assert.True(t, dt.In(loc).Equal(time.Date(2020, 2, 29, 1, 42, 31, 0, time.UTC)))
+
+	_, loc, err = ParseOptions{}.ParseDateTimeOffset("2020-02-29T03:42:31Z")
+	assert.NoError(t, err)
+	assert.Equal(t, time.UTC, loc)
+
+	_, loc, err = ParseOptions{}.ParseDateTimeOffset("2020-02-29T03:42:31")
+	assert.NoError(t, err)
+	assert.Nil(t, loc)
+}
+
+func TestParseOptions_EndOfDay(t *testing.T) {
+	_, err := ParseOptions{}.ParseTime("24:00:00")
+	assert.Error(t, err)
+
+	for _, policy := range []EndOfDayPolicy{EndOfDayKeep, EndOfDayNormalize} {
+		o := ParseOptions{EndOfDay: policy}
+		for _, s := range []string{"24:00", "24:00:00", "24:00:00.000", "24:00:00,000000000"} {
+			tm, err := o.ParseTime(s)
+			assert.NoError(t, err, s)
+			assert.True(t, tm.IsEndOfDay(), s)
+			assert.Equal(t, "24:00:00", tm.String())
+		}
+		for _, s := range []string{"24:00:01", "24:01", "24:00:00.1", "24:00:00.", "24:00:00.0000000000", "25:00"} {
+			_, err := o.ParseTime(s)
+			assert.Error(t, err, s)
+		}
+		tm, err := o.ParseTime("23:59:59")
+		assert.NoError(t, err)
+		assert.False(t, tm.IsEndOfDay())
+	}
+
+	dt, err := ParseOptions{EndOfDay: EndOfDayKeep}.ParseDateTime("2020-02-29T24:00")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{Hour: 24}}, dt)
+	assert.Equal(t, "2020-02-29T24:00:00", dt.String())
+	assert.False(t, dt.IsValid())
+
+	dt, err = ParseOptions{EndOfDay: EndOfDayNormalize}.ParseDateTime("2020-02-29T24:00:00")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{2020, 3, 1}, Time{}}, dt)
+
+	dt, err = ParseOptions{EndOfDay: EndOfDayNormalize}.ParseDateTime("2020-12-31T24:00")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{2021, 1, 1}, Time{}}, dt)
+}
+
+func TestParseOptions_LeapSecond(t *testing.T) {
+	type TC struct {
+		In     string
+		Policy LeapSecondPolicy
+		Out    Time
+		HasErr bool
+	}
+	tcs := []TC{
+		TC{In: "23:59:60", Policy: LeapSecondClamp, Out: Time{23, 59, 59, 999999999}},
+		TC{In: "23:59:60.5", Policy: LeapSecondClamp, Out: Time{23, 59, 59, 999999999}},
+		TC{In: "08:59:60", Policy: LeapSecondClamp, Out: Time{8, 59, 59, 999999999}},
+		TC{In: "12:30:60.5", Policy: LeapSecondCarry, Out: Time{12, 31, 0, 500000000}},
+		TC{In: "23:59:60", Policy: LeapSecondCarry, Out: Time{}},
+		TC{In: "23:59:59", Policy: LeapSecondCarry, Out: Time{23, 59, 59, 0}},
+		/* === ERRORS === */
+		TC{In: "23:59:60", Policy: LeapSecondReject, HasErr: true},
+		TC{In: "23:59:61", Policy: LeapSecondClamp, HasErr: true},
+		TC{In: "23:60:00", Policy: LeapSecondCarry, HasErr: true},
+		TC{In: "23:59:600", Policy: LeapSecondCarry, HasErr: true},
+		TC{In: "23:59:60.1234567890", Policy: LeapSecondClamp, HasErr: true},
+	}
+	for _, tc := range tcs {
+		tm, err := ParseOptions{LeapSecond: tc.Policy}.ParseTime(tc.In)
+		if tc.HasErr {
+			assert.Error(t, err, tc.In)
+			continue
+		}
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, tm, tc.In)
+	}
+
+	dt, err := ParseOptions{LeapSecond: LeapSecondCarry}.ParseDateTime("2016-12-31T23:59:60")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{2017, 1, 1}, Time{}}, dt)
+
+	dt, err = ParseOptions{LeapSecond: LeapSecondClamp}.ParseDateTime("2016-12-31T23:59:60")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{2016, 12, 31}, Time{23, 59, 59, 999999999}}, dt)
+}
+
+func TestParseOptions_LeapSecond_Scan(t *testing.T) {
+	var dt DateTime
+	assert.Error(t, dt.Scan("2016-12-31T23:59:60"))
+
+	opts := SQLOptions{Parse: ParseOptions{LeapSecond: LeapSecondCarry}}
+	assert.NoError(t, opts.Column(&dt).Scan("2016-12-31T23:59:60"))
+	assert.Equal(t, DateTime{Date{2017, 1, 1}, Time{}}, dt)
+
+	var tm Time
+	assert.NoError(t, opts.Column(&tm).Scan("23:59:60"))
+	assert.Equal(t, Time{}, tm)
+}
+
+func TestParseOptions_ExpandedYears(t *testing.T) {
+	expanded := ParseOptions{ExpandedYears: true}
+	type TC struct {
+		In  string
+		Out Date
+	}
+	tcs := []TC{
+		TC{In: "2020-03-04", Out: Date{2020, 3, 4}},
+		TC{In: "+12020-03-04", Out: Date{12020, 3, 4}},
+		TC{In: "-0044-03-15", Out: Date{-44, 3, 15}},
+		TC{In: "+002020-03-04", Out: Date{2020, 3, 4}},
+		TC{In: "-1000000-01-01", Out: Date{-1000000, 1, 1}},
+	}
+	for _, tc := range tcs {
+		d, err := expanded.ParseDate(tc.In)
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, d, tc.In)
+
+		if tc.In[0] == '+' || tc.In[0] == '-' {
+			_, err = ParseDate(tc.In)
+			assert.Error(t, err, tc.In)
+		}
+	}
+
+	for _, s := range []string{"12020-03-04", "+202-03-04", "+1234567890-01-01", "-44-03-15"} {
+		_, err := expanded.ParseDate(s)
+		assert.Error(t, err, s)
+	}
+	_, err := expanded.ParseDate("+202-03-04")
+	assert.EqualError(t, err, `civil: parsing "+202-03-04": expected 4 to 9 digits for year at offset 1`)
+
+	dt, err := expanded.ParseDateTime("-0044-03-15T12:00:00")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{Date{-44, 3, 15}, Time{Hour: 12}}, dt)
+}
+
+func TestParseOptions_Strict(t *testing.T) {
+	strict := ParseOptions{Strict: true}
+
+	d, err := ParseOptions{}.ParseDate("0000-00-00")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{}, d)
+	_, err = strict.ParseDate("0000-00-00")
+	assert.EqualError(t, err, `civil: parsing "0000-00-00": month 0 out of range [1,12] at offset 5`)
+	_, err = strict.ParseDate("2021/02/30")
+	assert.Error(t, err)
+
+	d, err = strict.ParseDate("2020-02-29")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{2020, 2, 29}, d)
+
+	_, err = strict.ParseDateTime("0000-00-00T00:00:00")
+	assert.Error(t, err)
+	dt, err := ParseOptions{}.ParseDateTime("0000-00-00T00:00:00")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTime{}, dt)
+}
+
+func TestParseOptions_Strict_Decode(t *testing.T) {
+	opts := ParseOptions{Strict: true}
+
+	var d Date
+	err := opts.Target(&d).UnmarshalJSON([]byte(`"2021-02-30"`))
+	assert.EqualError(t, err, `invalid date: 2021-02-30: civil: parsing "2021-02-30": day 30 out of range [1,28] for February 2021 at offset 8`)
+	assert.Error(t, opts.Target(&d).UnmarshalJSON([]byte(`"0000-00-00"`)))
+	assert.Error(t, SQLOptions{Parse: opts}.Column(&d).Scan("0000-00-00"))
+}
+
+func TestParseOptions_Target_Errors(t *testing.T) {
+	var s string
+	err := ParseOptions{}.Target(&s).UnmarshalText([]byte("2020-02-29"))
+	assert.True(t, errors.Is(err, ErrUnsupportedType))
+	assert.EqualError(t, err, "civil: unsupported type: cannot decode into *string")
+	assert.True(t, errors.Is(ParseOptions{}.Target(Date{}).UnmarshalJSON([]byte(`"2020-02-29"`)), ErrUnsupportedType))
+}
@@ -0,0 +1,206 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// FormatPattern returns the date formatted according to a java.time
+// (CLDR) style pattern such as "dd/MM/yyyy". See DateTime.FormatPattern for
+// the supported pattern letters. Time-of-day letters are rejected, as
+// java.time.LocalDate does.
+func (d Date) FormatPattern(pattern string) (string, error) {
+	b, err := appendPattern(nil, pattern, d.In(time.UTC), true, false)
+	return string(b), err
+}
+
+// FormatPattern returns the time formatted according to a java.time (CLDR)
+// style pattern such as "HH:mm:ss". See DateTime.FormatPattern for the
+// supported pattern letters. Date letters are rejected, as
+// java.time.LocalTime does.
+func (t Time) FormatPattern(pattern string) (string, error) {
+	b, err := appendPattern(nil, pattern, t.onDate0(), false, true)
+	return string(b), err
+}
+
+// FormatPattern returns the datetime formatted according to a java.time
+// (CLDR) style pattern such as "dd/MM/yyyy HH:mm:ss". The supported pattern
+// letters are:
+//
+//	y, u   year; "yy" gives the last two digits, otherwise the count is the minimum width
+//	M, L   month; "M" and "MM" are numeric, "MMM" abbreviated, "MMMM" full, "MMMMM" narrow
+//	d      day of the month
+//	D      day of the year
+//	Q, q   quarter; "Q" and "QQ" are numeric, "QQQ" gives "Q1", "QQQQ" gives "1st quarter"
+//	E      weekday; "E" to "EEE" abbreviated, "EEEE" full, "EEEEE" narrow
+//	a      AM or PM
+//	H      hour of the day (0-23)
+//	k      clock hour of the day (1-24)
+//	K      hour of AM/PM (0-11)
+//	h      clock hour of AM/PM (1-12)
+//	m      minute
+//	s      second
+//	S      fraction of the second, truncated to the number of letters (at most 9)
+//	n      nanosecond of the second
+//
+// Unless noted otherwise, the number of repeated letters gives the minimum,
+// zero-padded width of a numeric field. Text enclosed in single quotes is
+// copied literally, and two consecutive single quotes produce one.
+// Characters other than ASCII letters are copied literally.
+//
+// An error is returned for any other letter, including the zone letters
+// (z, Z, O, V, X and x), because civil values carry no location, and for an
+// unterminated quote.
+func (dt DateTime) FormatPattern(pattern string) (string, error) {
+	b, err := appendPattern(nil, pattern, dt.In(time.UTC), true, true)
+	return string(b), err
+}
+
+func appendPattern(b []byte, pattern string, t time.Time, hasDate, hasTime bool) ([]byte, error) {
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+
+		if c == '\'' {
+			if i+1 < len(pattern) && pattern[i+1] == '\'' {
+				b = append(b, '\'')
+				i += 2
+				continue
+			}
+			i++
+			for {
+				if i == len(pattern) {
+					return nil, fmt.Errorf("civil: unterminated quote in pattern %q", pattern)
+				}
+				if pattern[i] == '\'' {
+					if i+1 < len(pattern) && pattern[i+1] == '\'' {
+						b = append(b, '\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				b = append(b, pattern[i])
+				i++
+			}
+			continue
+		}
+
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			b = append(b, c)
+			i++
+			continue
+		}
+
+		n := 1
+		for i+n < len(pattern) && pattern[i+n] == c {
+			n++
+		}
+		i += n
+
+		switch c {
+		case 'y', 'u', 'M', 'L', 'd', 'D', 'Q', 'q', 'E':
+			if !hasDate {
+				return nil, fmt.Errorf("civil: pattern letter %q is not supported by Time", c)
+			}
+		case 'a', 'H', 'k', 'K', 'h', 'm', 's', 'S', 'n':
+			if !hasTime {
+				return nil, fmt.Errorf("civil: pattern letter %q is not supported by Date", c)
+			}
+		}
+
+		switch c {
+		case 'y', 'u':
+			if n == 2 {
+				b = appendInt(b, t.Year()%100, 2, '0')
+			} else {
+				b = appendInt(b, t.Year(), n, '0')
+			}
+		case 'M', 'L':
+			switch {
+			case n <= 2:
+				b = appendInt(b, int(t.Month()), n, '0')
+			case n == 3:
+				b = append(b, t.Month().String()[:3]...)
+			case n == 4:
+				b = append(b, t.Month().String()...)
+			default:
+				b = append(b, t.Month().String()[0])
+			}
+		case 'd':
+			b = appendInt(b, t.Day(), n, '0')
+		case 'D':
+			b = appendInt(b, t.YearDay(), n, '0')
+		case 'Q', 'q':
+			q := (int(t.Month())-1)/3 + 1
+			switch {
+			case n <= 2:
+				b = appendInt(b, q, n, '0')
+			case n == 3:
+				b = appendInt(append(b, 'Q'), q, 1, '0')
+			default:
+				b = append(b, [...]string{"1st", "2nd", "3rd", "4th"}[q-1]...)
+				b = append(b, " quarter"...)
+			}
+		case 'E':
+			switch {
+			case n <= 3:
+				b = append(b, t.Weekday().String()[:3]...)
+			case n == 4:
+				b = append(b, t.Weekday().String()...)
+			default:
+				b = append(b, t.Weekday().String()[0])
+			}
+		case 'a':
+			if t.Hour() < 12 {
+				b = append(b, "AM"...)
+			} else {
+				b = append(b, "PM"...)
+			}
+		case 'H':
+			b = appendInt(b, t.Hour(), n, '0')
+		case 'k':
+			h := t.Hour()
+			if h == 0 {
+				h = 24
+			}
+			b = appendInt(b, h, n, '0')
+		case 'K':
+			b = appendInt(b, t.Hour()%12, n, '0')
+		case 'h':
+			b = appendInt(b, hour12(t.Hour()), n, '0')
+		case 'm':
+			b = appendInt(b, t.Minute(), n, '0')
+		case 's':
+			b = appendInt(b, t.Second(), n, '0')
+		case 'S':
+			if n > 9 {
+				return nil, fmt.Errorf("civil: too many pattern letters %q, at most 9 are allowed", c)
+			}
+			frac := t.Nanosecond()
+			for j := n; j < 9; j++ {
+				frac /= 10
+			}
+			b = appendInt(b, frac, n, '0')
+		case 'n':
+			b = appendInt(b, t.Nanosecond(), n, '0')
+		default:
+			return nil, fmt.Errorf("civil: unsupported pattern letter %q", c)
+		}
+	}
+	return b, nil
+}
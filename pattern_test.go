@@ -0,0 +1,82 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTime_FormatPattern(t *testing.T) {
+	dt := DateTime{Date: Date{2020, 2, 9}, Time: Time{0, 4, 5, 123456789}}
+
+	type TC struct {
+		Pattern string
+		Out     string
+		HasErr  bool
+	}
+	tcs := []TC{
+		TC{Pattern: "dd/MM/yyyy HH:mm:ss", Out: "09/02/2020 00:04:05"},
+		TC{Pattern: "yyyy-MM-dd'T'HH:mm:ss.SSS", Out: "2020-02-09T00:04:05.123"},
+		TC{Pattern: "d.M.yy", Out: "9.2.20"},
+		TC{Pattern: "EEE, d MMM uuuu", Out: "Sun, 9 Feb 2020"},
+		TC{Pattern: "EEEE MMMM EEEEE MMMMM", Out: "Sunday February S F"},
+		TC{Pattern: "DDD Q QQQ QQQQ", Out: "040 1 Q1 1st quarter"},
+		TC{Pattern: "h:mm a|K|k|H", Out: "12:04 AM|0|24|0"},
+		TC{Pattern: "SSSSSSSSS n S", Out: "123456789 123456789 1"},
+		TC{Pattern: "'o''clock' ''", Out: "o'clock '"},
+		/* === ERRORS === */
+		TC{Pattern: "yyyy-MM-dd HH:mm z", HasErr: true},
+		TC{Pattern: "yyyy-MM-dd'T", HasErr: true},
+		TC{Pattern: "SSSSSSSSSS", HasErr: true},
+		TC{Pattern: "yyyy-ww", HasErr: true},
+	}
+	for _, tc := range tcs {
+		out, err := dt.FormatPattern(tc.Pattern)
+		if tc.HasErr {
+			assert.Error(t, err, tc.Pattern)
+			continue
+		}
+		assert.NoError(t, err, tc.Pattern)
+		assert.Equal(t, tc.Out, out, tc.Pattern)
+	}
+}
+
+func TestDate_FormatPattern(t *testing.T) {
+	d := Date{Year: 2020, Month: 2, Day: 29}
+
+	out, err := d.FormatPattern("dd/MM/yyyy")
+	assert.NoError(t, err)
+	assert.Equal(t, "29/02/2020", out)
+
+	_, err = d.FormatPattern("yyyy-MM-dd HH:mm")
+	assert.Error(t, err)
+}
+
+func TestTime_FormatPattern(t *testing.T) {
+	tm := Time{Hour: 13, Minute: 5, Second: 9}
+
+	out, err := tm.FormatPattern("HH:mm:ss")
+	assert.NoError(t, err)
+	assert.Equal(t, "13:05:09", out)
+
+	out, err = tm.FormatPattern("hh:mm a")
+	assert.NoError(t, err)
+	assert.Equal(t, "01:05 PM", out)
+
+	_, err = tm.FormatPattern("yyyy HH:mm")
+	assert.Error(t, err)
+}
@@ -0,0 +1,431 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Period is an amount of calendar time, as written in ISO 8601 duration
+// form: "P1Y2M3D", "P3DT4H" or "PT0.5S". Unlike a time.Duration, its years,
+// months and days have no fixed length, so that adding one month to a date
+// moves it to the same day of the next month whatever the month's length.
+//
+// The fields may have either sign and are kept as given rather than
+// normalized, so P1M and P30D, or PT90M and PT1H30M, are different
+// periods. Nanoseconds is the fraction of the seconds and has the same
+// sign as Seconds.
+type Period struct {
+	Years       int
+	Months      int
+	Days        int
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+}
+
+// ParsePeriod parses an ISO 8601 duration of the form PnYnMnWnDTnHnMnS,
+// in which any of the elements may be omitted but at least one must be
+// present, and T must be followed by a time element. Weeks are counted as
+// seven days. As in java.time, a leading '-' negates the whole period and
+// each number may carry its own sign, as in "P-1M3D" and "-PT1H". Only
+// the seconds may have a fractional part, of one to nine digits after a
+// point or comma.
+func ParsePeriod(s string) (Period, error) {
+	fail := func(reason string) (Period, error) {
+		return Period{}, fmt.Errorf("%w: %q is not an ISO 8601 period: %s", ErrInvalidFormat, s, reason)
+	}
+
+	in := s
+	neg := false
+	if in != "" && (in[0] == '-' || in[0] == '+') {
+		neg = in[0] == '-'
+		in = in[1:]
+	}
+	if in == "" || in[0] != 'P' {
+		return fail("missing P")
+	}
+	in = in[1:]
+	if in == "" {
+		return fail("no elements")
+	}
+
+	var p Period
+	fields := [...]*int{&p.Years, &p.Months, &p.Days, &p.Days, &p.Hours, &p.Minutes, &p.Seconds}
+	next := 0 // index into fields of the next designator allowed
+	inTime := false
+	for in != "" {
+		if in[0] == 'T' {
+			if inTime || len(in) == 1 {
+				return fail("misplaced T")
+			}
+			inTime = true
+			in = in[1:]
+			continue
+		}
+
+		i := 0
+		if in[0] == '-' || in[0] == '+' {
+			i++
+		}
+		start := i
+		for i < len(in) && isASCIIDigit(in[i]) {
+			i++
+		}
+		if i == start {
+			return fail("missing number")
+		}
+		n, err := strconv.Atoi(in[:i])
+		if err != nil {
+			return fail("number out of range")
+		}
+		frac, hasFrac := 0, false
+		if i < len(in) && (in[i] == '.' || in[i] == ',') {
+			j := i + 1
+			for j < len(in) && isASCIIDigit(in[j]) {
+				j++
+			}
+			if j == i+1 || j-i-1 > 9 {
+				return fail("fraction must have one to nine digits")
+			}
+			frac, _ = strconv.Atoi(in[i+1:j] + strings.Repeat("0", 9-(j-i-1)))
+			if in[0] == '-' {
+				frac = -frac
+			}
+			hasFrac = true
+			i = j
+		}
+		if i == len(in) {
+			return fail("missing designator")
+		}
+
+		var idx int
+		switch d := in[i]; {
+		case !inTime && d == 'Y':
+			idx = 0
+		case !inTime && d == 'M':
+			idx = 1
+		case !inTime && d == 'W':
+			idx, n = 2, n*7
+		case !inTime && d == 'D':
+			idx = 3
+		case inTime && d == 'H':
+			idx = 4
+		case inTime && d == 'M':
+			idx = 5
+		case inTime && d == 'S':
+			idx = 6
+		default:
+			return fail(fmt.Sprintf("unexpected designator %q", d))
+		}
+		if idx < next {
+			return fail("designators out of order")
+		}
+		if hasFrac && idx != 6 {
+			return fail("only seconds may have a fraction")
+		}
+		*fields[idx] += n
+		if idx == 6 {
+			p.Nanoseconds = frac
+		}
+		next = idx + 1
+		in = in[i+1:]
+	}
+	if neg {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// MustParsePeriod is like ParsePeriod but panics if s cannot be parsed.
+func MustParsePeriod(s string) Period {
+	p, err := ParsePeriod(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the period in ISO 8601 duration form, with each nonzero
+// field followed by its designator and carrying its own sign, as in
+// "P1Y-2M" and "PT1.5S". The zero period is "P0D".
+func (p Period) String() string {
+	return string(p.appendString(make([]byte, 0, 32)))
+}
+
+func (p Period) appendString(b []byte) []byte {
+	if p.IsZero() {
+		return append(b, "P0D"...)
+	}
+	b = append(b, 'P')
+	b = appendPeriodField(b, p.Years, 'Y')
+	b = appendPeriodField(b, p.Months, 'M')
+	b = appendPeriodField(b, p.Days, 'D')
+
+	secs := p.Seconds + p.Nanoseconds/1e9
+	nanos := p.Nanoseconds % 1e9
+	if secs > 0 && nanos < 0 {
+		secs, nanos = secs-1, nanos+1e9
+	} else if secs < 0 && nanos > 0 {
+		secs, nanos = secs+1, nanos-1e9
+	}
+	if p.Hours == 0 && p.Minutes == 0 && secs == 0 && nanos == 0 {
+		return b
+	}
+	b = append(b, 'T')
+	b = appendPeriodField(b, p.Hours, 'H')
+	b = appendPeriodField(b, p.Minutes, 'M')
+	if secs == 0 && nanos == 0 {
+		return b
+	}
+	if secs == 0 && nanos < 0 {
+		b = append(b, '-')
+	}
+	b = strconv.AppendInt(b, int64(secs), 10)
+	if nanos != 0 {
+		if nanos < 0 {
+			nanos = -nanos
+		}
+		b = append(b, '.')
+		b = appendInt(b, nanos, 9, '0')
+		for b[len(b)-1] == '0' {
+			b = b[:len(b)-1]
+		}
+	}
+	return append(b, 'S')
+}
+
+func appendPeriodField(b []byte, n int, designator byte) []byte {
+	if n == 0 {
+		return b
+	}
+	b = strconv.AppendInt(b, int64(n), 10)
+	return append(b, designator)
+}
+
+// IsZero reports whether all the fields of the period are zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns the period with every field negated.
+func (p Period) Negate() Period {
+	return Period{-p.Years, -p.Months, -p.Days, -p.Hours, -p.Minutes, -p.Seconds, -p.Nanoseconds}
+}
+
+// Duration returns the time fields of the period as a time.Duration,
+// ignoring the years, months and days, whose lengths vary.
+func (p Period) Duration() time.Duration {
+	return time.Duration(p.Hours)*time.Hour + time.Duration(p.Minutes)*time.Minute +
+		time.Duration(p.Seconds)*time.Second + time.Duration(p.Nanoseconds)
+}
+
+// AddPeriod returns the date p later, adding the years, months and days as
+// time.Time.AddDate does, so that a day past the end of the resulting
+// month rolls over into the next. The time fields of p are ignored.
+func (d Date) AddPeriod(p Period) Date {
+	return d.AddDate(p.Years, p.Months, p.Days)
+}
+
+// AddPeriod returns the datetime p later. The years, months and days are
+// added first, as by Date.AddPeriod, and then the time fields, carrying
+// into the date as needed.
+func (dt DateTime) AddPeriod(p Period) DateTime {
+	return DateTimeOf(dt.In(time.UTC).AddDate(p.Years, p.Months, p.Days).Add(p.Duration()))
+}
+
+// Sub returns the calendar period from start to d in years, months and
+// days, such as the P1Y2M3D from 2019-01-28 to 2020-03-31, rather than the
+// number of days. All fields of the result have the same sign, negative if
+// d is before start, and the months are counted so that
+// start.AddPeriod(d.Sub(start)) == d always holds. Months are whole only
+// when the day of the month is reached: from January 31 the period to
+// February 29 is P29D, and to March 1 is P30D, since one month after
+// January 31 is March 2 under AddPeriod's rules.
+func (d Date) Sub(start Date) Period {
+	months := d.MonthsSince(start)
+	days := d.DaysSince(start.AddMonths(months))
+	return Period{Years: months / 12, Months: months % 12, Days: days}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of p.String().
+func (p Period) MarshalText() ([]byte, error) {
+	return p.appendString(nil), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The period is expected in a form accepted by ParsePeriod.
+func (p *Period) UnmarshalText(data []byte) error {
+	val, err := ParsePeriod(string(data))
+	if err != nil {
+		return err
+	}
+	*p = val
+	return nil
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface. The period
+// is written as a string in the form of String.
+func (p Period) MarshalJSON() ([]byte, error) {
+	b := append(make([]byte, 0, 34), '"')
+	b = p.appendString(b)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves the period unchanged.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%w: period should be a string, got %s", ErrInvalidFormat, data)
+	}
+	return p.UnmarshalText([]byte(s))
+}
+
+// Value implements the database/sql/driver valuer interface. The period is
+// written in ISO 8601 form, which PostgreSQL accepts for interval columns.
+func (p Period) Value() (driver.Value, error) {
+	return p.String(), nil
+}
+
+// Scan implements the database/sql scanner interface. It accepts string and
+// []byte values in ISO 8601 form or in PostgreSQL's default interval
+// output, as in "1 year 2 mons 3 days 04:05:06.5". A NULL is an error
+// wrapping ErrNull; use SQLOptions.Column with NullAsZero set to scan it as
+// the zero Period.
+func (p *Period) Scan(value interface{}) error {
+	return p.scan(SQLOptions{}, value)
+}
+
+// scan is like Scan but follows the options o.
+func (p *Period) scan(o SQLOptions, value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		if !o.NullAsZero {
+			return fmt.Errorf("%w: cannot scan NULL into Period", ErrNull)
+		}
+		*p = Period{}
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T into Period; want string or []byte", ErrUnsupportedType, value)
+	}
+	var val Period
+	var err error
+	if strings.Contains(s, "P") {
+		val, err = ParsePeriod(s)
+	} else {
+		val, err = parsePostgresInterval(s)
+	}
+	if err != nil {
+		return err
+	}
+	*p = val
+	return nil
+}
+
+// parsePostgresInterval parses an interval in PostgreSQL's default output
+// style: numbers of years, months and days followed by their units, and an
+// optional signed [-]HH:MM:SS[.ffffff] time.
+func parsePostgresInterval(s string) (Period, error) {
+	fail := func() (Period, error) {
+		return Period{}, fmt.Errorf("%w: %q is not a PostgreSQL interval", ErrInvalidFormat, s)
+	}
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return fail()
+	}
+	var p Period
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		if strings.Contains(f, ":") {
+			t, ok := parseIntervalTime(f)
+			if !ok {
+				return fail()
+			}
+			p.Hours, p.Minutes, p.Seconds, p.Nanoseconds = t.Hours, t.Minutes, t.Seconds, t.Nanoseconds
+			continue
+		}
+		n, err := strconv.Atoi(f)
+		if err != nil || i+1 == len(fields) {
+			return fail()
+		}
+		i++
+		switch fields[i] {
+		case "year", "years":
+			p.Years = n
+		case "mon", "mons":
+			p.Months = n
+		case "day", "days":
+			p.Days = n
+		default:
+			return fail()
+		}
+	}
+	return p, nil
+}
+
+// parseIntervalTime parses the [-]HH:MM:SS[.ffffff] time of a PostgreSQL
+// interval, whose sign applies to all its fields.
+func parseIntervalTime(s string) (Period, bool) {
+	neg := false
+	if s[0] == '-' || s[0] == '+' {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return Period{}, false
+	}
+	var p Period
+	var err error
+	if p.Hours, err = parseDigits(parts[0]); err != nil {
+		return Period{}, false
+	}
+	if p.Minutes, err = parseDigits(parts[1]); err != nil {
+		return Period{}, false
+	}
+	sec, frac := parts[2], ""
+	if i := strings.IndexByte(sec, '.'); i >= 0 {
+		sec, frac = sec[:i], sec[i+1:]
+		if frac == "" || len(frac) > 9 {
+			return Period{}, false
+		}
+		if p.Nanoseconds, err = parseDigits(frac + strings.Repeat("0", 9-len(frac))); err != nil {
+			return Period{}, false
+		}
+	}
+	if p.Seconds, err = parseDigits(sec); err != nil {
+		return Period{}, false
+	}
+	if neg {
+		p = p.Negate()
+	}
+	return p, true
+}
@@ -0,0 +1,212 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriod_RoundTrip(t *testing.T) {
+	type TC struct {
+		In  string
+		Out Period
+	}
+	tcs := []TC{
+		TC{In: "P1Y2M3D", Out: Period{Years: 1, Months: 2, Days: 3}},
+		TC{In: "P3DT4H", Out: Period{Days: 3, Hours: 4}},
+		TC{In: "PT4H5M6S", Out: Period{Hours: 4, Minutes: 5, Seconds: 6}},
+		TC{In: "P1Y2M3DT4H5M6.5S", Out: Period{1, 2, 3, 4, 5, 6, 500000000}},
+		TC{In: "PT0.000000001S", Out: Period{Nanoseconds: 1}},
+		TC{In: "PT-0.5S", Out: Period{Nanoseconds: -500000000}},
+		TC{In: "PT-1.25S", Out: Period{Seconds: -1, Nanoseconds: -250000000}},
+		TC{In: "P-1M3D", Out: Period{Months: -1, Days: 3}},
+		TC{In: "PT90M", Out: Period{Minutes: 90}},
+		TC{In: "P30D", Out: Period{Days: 30}},
+		TC{In: "P0D", Out: Period{}},
+	}
+	for _, tc := range tcs {
+		p, err := ParsePeriod(tc.In)
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, p, tc.In)
+		assert.Equal(t, tc.In, p.String(), tc.In)
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	type TC struct {
+		In  string
+		Out Period
+	}
+	tcs := []TC{
+		TC{In: "P2W", Out: Period{Days: 14}},
+		TC{In: "P1W2D", Out: Period{Days: 9}},
+		TC{In: "-P1Y2M", Out: Period{Years: -1, Months: -2}},
+		TC{In: "+PT1H", Out: Period{Hours: 1}},
+		TC{In: "-P-1D", Out: Period{Days: 1}},
+		TC{In: "PT1,5S", Out: Period{Seconds: 1, Nanoseconds: 500000000}},
+		TC{In: "PT0S", Out: Period{}},
+		TC{In: "P0Y0M0DT0H0M0S", Out: Period{}},
+	}
+	for _, tc := range tcs {
+		p, err := ParsePeriod(tc.In)
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, p, tc.In)
+	}
+	assert.Equal(t, Period{Months: 1}, MustParsePeriod("P1M"))
+}
+
+func TestPeriod_Add(t *testing.T) {
+	d := Date{2020, 1, 31}
+	assert.Equal(t, Date{2021, 3, 3}, d.AddPeriod(MustParsePeriod("P1Y1M")))
+	assert.Equal(t, Date{2020, 2, 1}, d.AddPeriod(MustParsePeriod("P1DT23H")))
+	assert.Equal(t, Date{2019, 12, 31}, d.AddPeriod(MustParsePeriod("-P1M")))
+
+	dt := DateTime{Date{2020, 2, 28}, Time{Hour: 23}}
+	assert.Equal(t, DateTime{Date{2020, 3, 1}, Time{Hour: 0, Minute: 30}}, dt.AddPeriod(MustParsePeriod("P1DT1H30M")))
+	assert.Equal(t, DateTime{Date{2020, 2, 28}, Time{22, 59, 59, 500000000}}, dt.AddPeriod(MustParsePeriod("PT-0.5S")))
+
+	assert.Equal(t, 90*time.Minute+time.Second/2, MustParsePeriod("P1YT1H30M0.5S").Duration())
+	assert.Equal(t, MustParsePeriod("P-1Y2DT-3H"), MustParsePeriod("P1Y-2DT3H").Negate())
+	assert.True(t, Period{}.IsZero())
+}
+
+func TestDate_Sub(t *testing.T) {
+	type TC struct {
+		Start Date
+		End   Date
+		Out   string
+	}
+	tcs := []TC{
+		TC{Start: Date{2019, 1, 28}, End: Date{2020, 3, 31}, Out: "P1Y2M3D"},
+		TC{Start: Date{2020, 2, 29}, End: Date{2020, 2, 29}, Out: "P0D"},
+		TC{Start: Date{2020, 2, 29}, End: Date{2021, 2, 28}, Out: "P11M30D"},
+		TC{Start: Date{2020, 2, 29}, End: Date{2021, 3, 1}, Out: "P1Y"},
+		TC{Start: Date{2020, 1, 31}, End: Date{2020, 2, 29}, Out: "P29D"},
+		TC{Start: Date{2020, 1, 31}, End: Date{2020, 3, 1}, Out: "P30D"},
+		TC{Start: Date{2020, 1, 31}, End: Date{2020, 3, 2}, Out: "P1M"},
+		TC{Start: Date{2020, 1, 15}, End: Date{2020, 1, 14}, Out: "P-1D"},
+		TC{Start: Date{2021, 3, 31}, End: Date{2021, 2, 28}, Out: "P-1M-3D"},
+		TC{Start: Date{2021, 3, 1}, End: Date{2021, 2, 28}, Out: "P-1D"},
+		TC{Start: Date{2020, 3, 31}, End: Date{2018, 12, 25}, Out: "P-1Y-3M-6D"},
+	}
+	for _, tc := range tcs {
+		p := tc.End.Sub(tc.Start)
+		assert.Equal(t, tc.Out, p.String(), "%v to %v", tc.Start, tc.End)
+		assert.Equal(t, tc.End, tc.Start.AddPeriod(p), "%v to %v", tc.Start, tc.End)
+	}
+
+	// The round trip holds across every pair of days in a leap year and
+	// its neighbours.
+	start := Date{2019, 12, 1}
+	for i := 0; i < 430; i += 7 {
+		for j := 0; j < 430; j++ {
+			a, b := start.AddDays(i), start.AddDays(j)
+			p := b.Sub(a)
+			assert.Equal(t, b, a.AddPeriod(p), "%v to %v", a, b)
+			assert.False(t, (p.Years > 0 || p.Months > 0 || p.Days > 0) && (p.Years < 0 || p.Months < 0 || p.Days < 0), "%v to %v", a, b)
+		}
+	}
+}
+
+func TestPeriod_JSON(t *testing.T) {
+	type Lease struct {
+		Term Period `json:"term"`
+	}
+	b, err := json.Marshal(Lease{Term: Period{Years: 1, Months: 6}})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"term":"P1Y6M"}`, string(b))
+
+	var l Lease
+	assert.NoError(t, json.Unmarshal(b, &l))
+	assert.Equal(t, Period{Years: 1, Months: 6}, l.Term)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"term":null}`), &l))
+	assert.Equal(t, Period{Years: 1, Months: 6}, l.Term)
+}
+
+func TestPeriod_SQL(t *testing.T) {
+	v, err := MustParsePeriod("P1Y2M3DT4H5M6.5S").Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "P1Y2M3DT4H5M6.5S", v)
+
+	type TC struct {
+		In  interface{}
+		Out Period
+	}
+	tcs := []TC{
+		TC{In: "1 year 2 mons 3 days 04:05:06.5", Out: Period{1, 2, 3, 4, 5, 6, 500000000}},
+		TC{In: []byte("-2 years +1 mon"), Out: Period{Years: -2, Months: 1}},
+		TC{In: "1 day -00:00:01", Out: Period{Days: 1, Seconds: -1}},
+		TC{In: "100:00:00", Out: Period{Hours: 100}},
+		TC{In: "00:00:00", Out: Period{}},
+		TC{In: "P1Y-2M3DT-4H-5M-6S", Out: Period{1, -2, 3, -4, -5, -6, 0}},
+	}
+	for _, tc := range tcs {
+		var p Period
+		assert.NoError(t, p.Scan(tc.In), tc.In)
+		assert.Equal(t, tc.Out, p, tc.In)
+	}
+}
+
+/* === ERRORS === */
+
+func TestParsePeriod_Errors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"P",
+		"1D",
+		"PT",
+		"P1DT",
+		"P1D2Y",
+		"P1D1D",
+		"PT1S1M",
+		"P1H",
+		"PT1D",
+		"P1.5D",
+		"PT1.S",
+		"PT1.1234567890S",
+		"P1",
+		"PD",
+		"P--1D",
+		"p1d",
+		"P99999999999999999999D",
+		"P1DT1H ",
+	} {
+		_, err := ParsePeriod(s)
+		assert.True(t, errors.Is(err, ErrInvalidFormat), s)
+	}
+	_, err := ParsePeriod("P1D2Y")
+	assert.EqualError(t, err, `civil: invalid format: "P1D2Y" is not an ISO 8601 period: designators out of order`)
+	assert.Panics(t, func() { MustParsePeriod("1D") })
+}
+
+func TestPeriod_Errors(t *testing.T) {
+	p := Period{Days: 1}
+	assert.True(t, errors.Is(p.Scan(nil), ErrNull))
+	assert.True(t, errors.Is(p.Scan(int64(1)), ErrUnsupportedType))
+	for _, s := range []string{"", "1 week", "1 day 2", "1:2", "1 day 01:02:03.", "x days"} {
+		assert.True(t, errors.Is(p.Scan(s), ErrInvalidFormat), s)
+	}
+	assert.Equal(t, Period{Days: 1}, p)
+
+	assert.Error(t, p.UnmarshalJSON([]byte(`3`)))
+	assert.Error(t, p.UnmarshalText([]byte(`P1Q`)))
+	assert.Equal(t, Period{Days: 1}, p)
+}
@@ -0,0 +1,20 @@
+module github.com/openlyinc/civil/pgxcivil
+
+go 1.21
+
+require (
+	github.com/jackc/pgx/v5 v5.7.1
+	github.com/openlyinc/civil v0.0.0
+	github.com/stretchr/testify v1.8.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/kr/text v0.1.0 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/rogpeppe/go-internal v1.6.1 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/openlyinc/civil => ../
@@ -0,0 +1,177 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pgxcivil lets pgx v5 exchange civil values with PostgreSQL's
+// date, time and timestamp (without time zone) types over the binary
+// protocol, without a round-trip through strings or time zones.
+//
+// Register the types on each connection, for example in
+// pgxpool.Config.AfterConnect:
+//
+//	pgxcivil.Register(conn.TypeMap())
+//
+// after which civil.Date, civil.Time and civil.DateTime, and pointers to
+// them, can be used as query arguments and scan targets. A NULL cannot be
+// scanned into a civil value; use a pointer, or a civil.NullDate,
+// civil.NullTime or civil.NullDateTime through database/sql. To scan NULL
+// or the infinite dates and timestamps into civil values, scan into a
+// civil.SQLOptions column adapter, which pgx calls as a sql.Scanner:
+//
+//	opts := civil.SQLOptions{Infinity: civil.InfinityClamp}
+//	err := row.Scan(opts.Column(&d))
+package pgxcivil
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/openlyinc/civil"
+)
+
+// Register registers the civil types on m.
+func Register(m *pgtype.Map) {
+	m.TryWrapEncodePlanFuncs = append([]pgtype.TryWrapEncodePlanFunc{TryWrapEncodePlan}, m.TryWrapEncodePlanFuncs...)
+	m.TryWrapScanPlanFuncs = append([]pgtype.TryWrapScanPlanFunc{TryWrapScanPlan}, m.TryWrapScanPlanFuncs...)
+
+	m.RegisterDefaultPgType(civil.Date{}, "date")
+	m.RegisterDefaultPgType(civil.Time{}, "time")
+	m.RegisterDefaultPgType(civil.DateTime{}, "timestamp")
+}
+
+// TryWrapEncodePlan is a pgtype.TryWrapEncodePlanFunc that encodes civil
+// values with the date, time and timestamp codecs.
+func TryWrapEncodePlan(value interface{}) (plan pgtype.WrappedEncodePlanNextSetter, nextValue interface{}, ok bool) {
+	switch value := value.(type) {
+	case civil.Date:
+		return &wrapEncodePlan{wrap: func(v interface{}) interface{} { return Date(v.(civil.Date)) }}, Date(value), true
+	case civil.Time:
+		return &wrapEncodePlan{wrap: func(v interface{}) interface{} { return Time(v.(civil.Time)) }}, Time(value), true
+	case civil.DateTime:
+		return &wrapEncodePlan{wrap: func(v interface{}) interface{} { return DateTime(v.(civil.DateTime)) }}, DateTime(value), true
+	}
+	return nil, nil, false
+}
+
+type wrapEncodePlan struct {
+	next pgtype.EncodePlan
+	wrap func(interface{}) interface{}
+}
+
+func (plan *wrapEncodePlan) SetNext(next pgtype.EncodePlan) { plan.next = next }
+
+func (plan *wrapEncodePlan) Encode(value interface{}, buf []byte) ([]byte, error) {
+	return plan.next.Encode(plan.wrap(value), buf)
+}
+
+// TryWrapScanPlan is a pgtype.TryWrapScanPlanFunc that scans date, time
+// and timestamp values into civil values.
+func TryWrapScanPlan(target interface{}) (plan pgtype.WrappedScanPlanNextSetter, nextDst interface{}, ok bool) {
+	switch target := target.(type) {
+	case *civil.Date:
+		return &wrapScanPlan{wrap: func(v interface{}) interface{} { return (*Date)(v.(*civil.Date)) }}, (*Date)(target), true
+	case *civil.Time:
+		return &wrapScanPlan{wrap: func(v interface{}) interface{} { return (*Time)(v.(*civil.Time)) }}, (*Time)(target), true
+	case *civil.DateTime:
+		return &wrapScanPlan{wrap: func(v interface{}) interface{} { return (*DateTime)(v.(*civil.DateTime)) }}, (*DateTime)(target), true
+	}
+	return nil, nil, false
+}
+
+type wrapScanPlan struct {
+	next pgtype.ScanPlan
+	wrap func(interface{}) interface{}
+}
+
+func (plan *wrapScanPlan) SetNext(next pgtype.ScanPlan) { plan.next = next }
+
+func (plan *wrapScanPlan) Scan(src []byte, dst interface{}) error {
+	return plan.next.Scan(src, plan.wrap(dst))
+}
+
+// Date is a civil.Date that implements the pgtype date interfaces.
+type Date civil.Date
+
+// ScanDate implements the pgtype.DateScanner interface. Infinite dates are
+// rejected with an error wrapping civil.ErrYearOutOfRange.
+func (d *Date) ScanDate(v pgtype.Date) error {
+	if err := checkNull(v.Valid, "civil.Date"); err != nil {
+		return err
+	}
+	if v.InfinityModifier != pgtype.Finite {
+		return (*civil.Date)(d).Scan(v.InfinityModifier.String())
+	}
+	*d = Date(civil.DateOf(v.Time))
+	return nil
+}
+
+// DateValue implements the pgtype.DateValuer interface.
+func (d Date) DateValue() (pgtype.Date, error) {
+	return pgtype.Date{Time: civil.Date(d).In(time.UTC), Valid: true}, nil
+}
+
+// Time is a civil.Time that implements the pgtype time interfaces.
+type Time civil.Time
+
+// ScanTime implements the pgtype.TimeScanner interface. PostgreSQL's
+// 24:00:00 is scanned as the civil end-of-day time.
+func (t *Time) ScanTime(v pgtype.Time) error {
+	if err := checkNull(v.Valid, "civil.Time"); err != nil {
+		return err
+	}
+	us := v.Microseconds
+	*t = Time{
+		Hour:       int(us / 3600e6),
+		Minute:     int(us / 60e6 % 60),
+		Second:     int(us / 1e6 % 60),
+		Nanosecond: int(us % 1e6 * 1000),
+	}
+	return nil
+}
+
+// TimeValue implements the pgtype.TimeValuer interface. PostgreSQL stores
+// microseconds, so finer fractions of a second are truncated.
+func (t Time) TimeValue() (pgtype.Time, error) {
+	us := (int64(t.Hour)*3600+int64(t.Minute)*60+int64(t.Second))*1e6 + int64(t.Nanosecond)/1000
+	return pgtype.Time{Microseconds: us, Valid: true}, nil
+}
+
+// DateTime is a civil.DateTime that implements the pgtype timestamp
+// interfaces.
+type DateTime civil.DateTime
+
+// ScanTimestamp implements the pgtype.TimestampScanner interface. Infinite
+// timestamps are rejected with an error wrapping civil.ErrYearOutOfRange.
+func (dt *DateTime) ScanTimestamp(v pgtype.Timestamp) error {
+	if err := checkNull(v.Valid, "civil.DateTime"); err != nil {
+		return err
+	}
+	if v.InfinityModifier != pgtype.Finite {
+		return (*civil.DateTime)(dt).Scan(v.InfinityModifier.String())
+	}
+	*dt = DateTime(civil.DateTimeOf(v.Time))
+	return nil
+}
+
+// TimestampValue implements the pgtype.TimestampValuer interface.
+func (dt DateTime) TimestampValue() (pgtype.Timestamp, error) {
+	return pgtype.Timestamp{Time: civil.DateTime(dt).In(time.UTC), Valid: true}, nil
+}
+
+func checkNull(valid bool, typeName string) error {
+	if !valid {
+		return fmt.Errorf("%w: cannot scan NULL into %s", civil.ErrNull, typeName)
+	}
+	return nil
+}
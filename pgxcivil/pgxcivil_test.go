@@ -0,0 +1,140 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgxcivil
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/openlyinc/civil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ pgtype.DateScanner      = (*Date)(nil)
+	_ pgtype.DateValuer       = Date{}
+	_ pgtype.TimeScanner      = (*Time)(nil)
+	_ pgtype.TimeValuer       = Time{}
+	_ pgtype.TimestampScanner = (*DateTime)(nil)
+	_ pgtype.TimestampValuer  = DateTime{}
+)
+
+func TestDate(t *testing.T) {
+	v, err := Date{2020, 2, 29}.DateValue()
+	assert.NoError(t, err)
+	assert.Equal(t, pgtype.Date{Time: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), Valid: true}, v)
+
+	var d civil.Date
+	assert.NoError(t, (*Date)(&d).ScanDate(v))
+	assert.Equal(t, civil.Date{Year: 2020, Month: 2, Day: 29}, d)
+}
+
+func TestTime(t *testing.T) {
+	v, err := Time{3, 42, 31, 876543219}.TimeValue()
+	assert.NoError(t, err)
+	assert.Equal(t, pgtype.Time{Microseconds: 13351876543, Valid: true}, v)
+
+	var tm civil.Time
+	assert.NoError(t, (*Time)(&tm).ScanTime(v))
+	assert.Equal(t, civil.Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876543000}, tm)
+
+	assert.NoError(t, (*Time)(&tm).ScanTime(pgtype.Time{Microseconds: 24 * 3600e6, Valid: true}))
+	assert.True(t, tm.IsEndOfDay())
+}
+
+func TestDateTime(t *testing.T) {
+	dt := civil.DateTime{Date: civil.Date{Year: 2020, Month: 2, Day: 29}, Time: civil.Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876000}}
+	v, err := DateTime(dt).TimestampValue()
+	assert.NoError(t, err)
+	assert.Equal(t, pgtype.Timestamp{Time: time.Date(2020, 2, 29, 3, 42, 31, 876000, time.UTC), Valid: true}, v)
+
+	var dt0 civil.DateTime
+	assert.NoError(t, (*DateTime)(&dt0).ScanTimestamp(v))
+	assert.Equal(t, dt, dt0)
+}
+
+type recordPlan struct{ got interface{} }
+
+func (p *recordPlan) Encode(value interface{}, buf []byte) ([]byte, error) {
+	p.got = value
+	return buf, nil
+}
+
+func (p *recordPlan) Scan(src []byte, dst interface{}) error {
+	p.got = dst
+	return nil
+}
+
+func TestTryWrapPlans(t *testing.T) {
+	plan, next, ok := TryWrapEncodePlan(civil.Date{Year: 2020, Month: 2, Day: 29})
+	assert.True(t, ok)
+	assert.Equal(t, Date{2020, 2, 29}, next)
+	rec := &recordPlan{}
+	plan.SetNext(rec)
+	_, err := plan.Encode(civil.Date{Year: 2021, Month: 3, Day: 4}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, Date{2021, 3, 4}, rec.got)
+
+	var dt civil.DateTime
+	scan, dst, ok := TryWrapScanPlan(&dt)
+	assert.True(t, ok)
+	assert.Equal(t, (*DateTime)(&dt), dst)
+	scan.SetNext(rec)
+	assert.NoError(t, scan.Scan(nil, &dt))
+	assert.Equal(t, (*DateTime)(&dt), rec.got)
+
+	_, _, ok = TryWrapEncodePlan("2020-02-29")
+	assert.False(t, ok)
+	_, _, ok = TryWrapScanPlan(new(time.Time))
+	assert.False(t, ok)
+}
+
+func TestScan_Infinity(t *testing.T) {
+	m := pgtype.NewMap()
+	Register(m)
+	opts := civil.SQLOptions{NullAsZero: true, Infinity: civil.InfinityClamp}
+
+	var d civil.Date
+	assert.NoError(t, m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, []byte{0x7f, 0xff, 0xff, 0xff}, opts.Column(&d)))
+	assert.Equal(t, civil.MaxDate(), d)
+	assert.NoError(t, m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, nil, opts.Column(&d)))
+	assert.Equal(t, civil.Date{}, d)
+
+	var dt civil.DateTime
+	assert.NoError(t, m.Scan(pgtype.TimestampOID, pgtype.BinaryFormatCode, []byte{0x80, 0, 0, 0, 0, 0, 0, 0}, opts.Column(&dt)))
+	assert.Equal(t, civil.MinDateTime(), dt)
+
+	// Finite values scan as usual.
+	assert.NoError(t, m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, []byte{0, 0, 0x1c, 0xc4}, opts.Column(&d)))
+	assert.Equal(t, civil.Date{Year: 2020, Month: 2, Day: 29}, d)
+}
+
+/* === ERRORS === */
+
+func TestScan_Errors(t *testing.T) {
+	var d Date
+	assert.True(t, errors.Is(d.ScanDate(pgtype.Date{}), civil.ErrNull))
+	err := d.ScanDate(pgtype.Date{InfinityModifier: pgtype.Infinity, Valid: true})
+	assert.True(t, errors.Is(err, civil.ErrYearOutOfRange))
+
+	var tm Time
+	assert.True(t, errors.Is(tm.ScanTime(pgtype.Time{}), civil.ErrNull))
+
+	var dt DateTime
+	err = dt.ScanTimestamp(pgtype.Timestamp{InfinityModifier: pgtype.NegativeInfinity, Valid: true})
+	assert.True(t, errors.Is(err, civil.ErrYearOutOfRange))
+}
@@ -0,0 +1,209 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// TimeMillis is a Time whose text, JSON and SQL forms have exactly three
+// fractional digits, as in "15:04:05.000", for stable, fixed-width output
+// and APIs with a millisecond contract. Finer fractions are truncated. It
+// reads any form that Time reads.
+type TimeMillis Time
+
+// TimeMicros is a Time whose text, JSON and SQL forms have exactly six
+// fractional digits, as in "15:04:05.000000". Finer fractions are
+// truncated. It reads any form that Time reads.
+type TimeMicros Time
+
+// DateTimeMillis is a DateTime whose text, JSON and SQL forms have exactly
+// three fractional digits, as in "2006-01-02T15:04:05.000". Finer
+// fractions are truncated. It reads any form that DateTime reads.
+type DateTimeMillis DateTime
+
+// DateTimeMicros is a DateTime whose text, JSON and SQL forms have exactly
+// six fractional digits, as in "2006-01-02T15:04:05.000000". Finer
+// fractions are truncated. It reads any form that DateTime reads.
+type DateTimeMicros DateTime
+
+// String returns the time in the form "15:04:05.000".
+func (v TimeMillis) String() string {
+	return Time(v).StringPrecision(PrecisionMillis)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v TimeMillis) MarshalText() ([]byte, error) {
+	return Time(v).appendPrecision(nil, PrecisionMillis), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *TimeMillis) UnmarshalText(data []byte) error {
+	return (*Time)(v).UnmarshalText(data)
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v TimeMillis) MarshalJSON() ([]byte, error) {
+	return Time(v).marshalJSONPrecision(PrecisionMillis), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *TimeMillis) UnmarshalJSON(data []byte) error {
+	return (*Time)(v).UnmarshalJSON(data)
+}
+
+// Value implements the database/sql/driver valuer interface.
+func (v TimeMillis) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements the database/sql scanner interface.
+func (v *TimeMillis) Scan(value interface{}) error {
+	return (*Time)(v).Scan(value)
+}
+
+// String returns the time in the form "15:04:05.000000".
+func (v TimeMicros) String() string {
+	return Time(v).StringPrecision(PrecisionMicros)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v TimeMicros) MarshalText() ([]byte, error) {
+	return Time(v).appendPrecision(nil, PrecisionMicros), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *TimeMicros) UnmarshalText(data []byte) error {
+	return (*Time)(v).UnmarshalText(data)
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v TimeMicros) MarshalJSON() ([]byte, error) {
+	return Time(v).marshalJSONPrecision(PrecisionMicros), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *TimeMicros) UnmarshalJSON(data []byte) error {
+	return (*Time)(v).UnmarshalJSON(data)
+}
+
+// Value implements the database/sql/driver valuer interface.
+func (v TimeMicros) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements the database/sql scanner interface.
+func (v *TimeMicros) Scan(value interface{}) error {
+	return (*Time)(v).Scan(value)
+}
+
+func (t Time) marshalJSONPrecision(p Precision) []byte {
+	b := append(make([]byte, 0, len(RFC3339Time)+2), '"')
+	b = t.appendPrecision(b, p)
+	return append(b, '"')
+}
+
+// String returns the datetime in the form "2006-01-02T15:04:05.000".
+func (v DateTimeMillis) String() string {
+	return DateTime(v).StringPrecision(PrecisionMillis)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. As for
+// DateTime, a year outside [0,9999] is written in expanded form.
+func (v DateTimeMillis) MarshalText() ([]byte, error) {
+	return DateTime(v).marshalTextPrecision(PrecisionMillis)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *DateTimeMillis) UnmarshalText(data []byte) error {
+	return (*DateTime)(v).UnmarshalText(data)
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v DateTimeMillis) MarshalJSON() ([]byte, error) {
+	return DateTime(v).marshalJSONPrecision(PrecisionMillis)
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *DateTimeMillis) UnmarshalJSON(data []byte) error {
+	return (*DateTime)(v).UnmarshalJSON(data)
+}
+
+// Value implements the database/sql/driver valuer interface.
+func (v DateTimeMillis) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements the database/sql scanner interface.
+func (v *DateTimeMillis) Scan(value interface{}) error {
+	return (*DateTime)(v).Scan(value)
+}
+
+// String returns the datetime in the form "2006-01-02T15:04:05.000000".
+func (v DateTimeMicros) String() string {
+	return DateTime(v).StringPrecision(PrecisionMicros)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. As for
+// DateTime, a year outside [0,9999] is written in expanded form.
+func (v DateTimeMicros) MarshalText() ([]byte, error) {
+	return DateTime(v).marshalTextPrecision(PrecisionMicros)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *DateTimeMicros) UnmarshalText(data []byte) error {
+	return (*DateTime)(v).UnmarshalText(data)
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface.
+func (v DateTimeMicros) MarshalJSON() ([]byte, error) {
+	return DateTime(v).marshalJSONPrecision(PrecisionMicros)
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves v unchanged.
+func (v *DateTimeMicros) UnmarshalJSON(data []byte) error {
+	return (*DateTime)(v).UnmarshalJSON(data)
+}
+
+// Value implements the database/sql/driver valuer interface.
+func (v DateTimeMicros) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements the database/sql scanner interface.
+func (v *DateTimeMicros) Scan(value interface{}) error {
+	return (*DateTime)(v).Scan(value)
+}
+
+func (dt DateTime) marshalTextPrecision(p Precision) ([]byte, error) {
+	if err := checkYear(dt.Date.Year); err != nil {
+		return nil, fmt.Errorf("DateTime.MarshalText: %w", err)
+	}
+	return dt.appendExpandedPrecision(make([]byte, 0, len(RFC3339DateTime)), p), nil
+}
+
+func (dt DateTime) marshalJSONPrecision(p Precision) ([]byte, error) {
+	if err := checkYear(dt.Date.Year); err != nil {
+		return nil, fmt.Errorf("DateTime.MarshalJSON: %w", err)
+	}
+	b := append(make([]byte, 0, len(RFC3339DateTime)+2), '"')
+	b = dt.appendExpandedPrecision(b, p)
+	return append(b, '"'), nil
+}
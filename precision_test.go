@@ -0,0 +1,84 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrecisionTypes(t *testing.T) {
+	tm := Time{Hour: 3, Minute: 42, Second: 31, Nanosecond: 876543219}
+	dt := DateTime{Date: Date{2020, 2, 29}, Time: Time{3, 42, 31, 0}}
+
+	type TC struct {
+		In   fmt.Stringer
+		Want string
+	}
+	tcs := []TC{
+		TC{In: TimeMillis(tm), Want: "03:42:31.876"},
+		TC{In: TimeMicros(tm), Want: "03:42:31.876543"},
+		TC{In: DateTimeMillis(dt), Want: "2020-02-29T03:42:31.000"},
+		TC{In: DateTimeMicros(dt), Want: "2020-02-29T03:42:31.000000"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Want, tc.In.String())
+
+		b, err := json.Marshal(tc.In)
+		assert.NoError(t, err, tc.Want)
+		assert.Equal(t, `"`+tc.Want+`"`, string(b))
+	}
+
+	// Values read back in any form the underlying type reads.
+	var row struct {
+		T  TimeMillis
+		DT DateTimeMicros
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"T":"03:42:31.876543219","DT":"2020-02-29T03:42:31"}`), &row))
+	assert.Equal(t, TimeMillis(tm), row.T)
+	assert.Equal(t, DateTimeMicros(dt), row.DT)
+
+	var tm0 TimeMicros
+	assert.NoError(t, tm0.Scan([]byte("03:42:31.5")))
+	assert.Equal(t, TimeMicros{3, 42, 31, 500000000}, tm0)
+	v, err := tm0.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "03:42:31.500000", v)
+
+	var dt0 DateTimeMillis
+	assert.NoError(t, dt0.Scan("2020-02-29T03:42:31"))
+	assert.Equal(t, DateTimeMillis(dt), dt0)
+	v, err = dt0.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-02-29T03:42:31.000", v)
+}
+
+/* === ERRORS === */
+
+func TestPrecisionTypes_Errors(t *testing.T) {
+	dt := DateTimeMillis{Date: Date{Year: 10000, Month: 1, Day: 1}}
+	_, err := dt.MarshalText()
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+	_, err = json.Marshal(DateTimeMicros(dt))
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+
+	var tm TimeMillis
+	assert.True(t, errors.Is(tm.UnmarshalJSON([]byte("42")), ErrInvalidFormat))
+	assert.Error(t, tm.UnmarshalText([]byte("25:00:00")))
+}
@@ -0,0 +1,114 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// The proto helpers convert to and from the well-known google.type.Date,
+// google.type.TimeOfDay and google.type.DateTime messages of
+// google.golang.org/genproto/googleapis/type without importing them. The
+// FromProto functions accept the generated message pointers, which satisfy
+// the getter interfaces below, including nil pointers. The ProtoFields
+// methods return the message fields, so that a message is built with, for
+// example,
+//
+//	y, m, d := date.ProtoFields()
+//	pb := &datepb.Date{Year: y, Month: m, Day: d}
+
+// ProtoDate is implemented by *google.type.Date.
+type ProtoDate interface {
+	GetYear() int32
+	GetMonth() int32
+	GetDay() int32
+}
+
+// ProtoTimeOfDay is implemented by *google.type.TimeOfDay.
+type ProtoTimeOfDay interface {
+	GetHours() int32
+	GetMinutes() int32
+	GetSeconds() int32
+	GetNanos() int32
+}
+
+// ProtoDateTime is implemented by *google.type.DateTime.
+type ProtoDateTime interface {
+	ProtoDate
+	ProtoTimeOfDay
+}
+
+// ProtoFields returns the fields of the google.type.Date for d.
+func (d Date) ProtoFields() (year, month, day int32) {
+	return int32(d.Year), int32(d.Month), int32(d.Day)
+}
+
+// DateFromProto returns the date represented by a google.type.Date. A
+// message with all fields zero is the zero Date. google.type.Date can also
+// represent a month and day without a year, or a year alone; those partial
+// dates have no Date counterpart and are rejected with ErrInvalidFormat.
+// Otherwise the fields are checked as by NewDate.
+func DateFromProto(p ProtoDate) (Date, error) {
+	year, month, day := int(p.GetYear()), time.Month(p.GetMonth()), int(p.GetDay())
+	switch {
+	case year == 0 && month == 0 && day == 0:
+		return Date{}, nil
+	case year == 0 || month == 0 || day == 0:
+		return Date{}, fmt.Errorf("%w: partial google.type.Date %04d-%02d-%02d", ErrInvalidFormat, year, month, day)
+	}
+	return NewDate(year, month, day)
+}
+
+// ProtoFields returns the fields of the google.type.TimeOfDay for t.
+func (t Time) ProtoFields() (hours, minutes, seconds, nanos int32) {
+	return int32(t.Hour), int32(t.Minute), int32(t.Second), int32(t.Nanosecond)
+}
+
+// TimeFromProto returns the time represented by a google.type.TimeOfDay,
+// checking the fields as by NewTime. google.type.TimeOfDay allows APIs to
+// use 24:00:00 for closing times and 60 seconds for leap seconds; neither
+// is accepted.
+func TimeFromProto(p ProtoTimeOfDay) (Time, error) {
+	return NewTime(int(p.GetHours()), int(p.GetMinutes()), int(p.GetSeconds()), int(p.GetNanos()))
+}
+
+// ProtoFields returns the fields of the google.type.DateTime for dt. The
+// time_offset field is left unset, which the message defines as civil time.
+func (dt DateTime) ProtoFields() (year, month, day, hours, minutes, seconds, nanos int32) {
+	year, month, day = dt.Date.ProtoFields()
+	hours, minutes, seconds, nanos = dt.Time.ProtoFields()
+	return year, month, day, hours, minutes, seconds, nanos
+}
+
+// DateTimeFromProto returns the datetime represented by a
+// google.type.DateTime. The year must be set; the other fields are checked
+// as by NewDateTime. The time_offset field cannot be read through
+// ProtoDateTime, so callers that accept messages with a UTC offset or time
+// zone must apply it themselves.
+func DateTimeFromProto(p ProtoDateTime) (DateTime, error) {
+	if p.GetYear() == 0 {
+		return DateTime{}, fmt.Errorf("%w: google.type.DateTime without a year", ErrInvalidFormat)
+	}
+	d, err := DateFromProto(p)
+	if err != nil {
+		return DateTime{}, err
+	}
+	t, err := TimeFromProto(p)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTime{Date: d, Time: t}, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// protoDateTime mimics the generated google.type messages, whose getters
+// return zero for a nil receiver.
+type protoDateTime struct {
+	Year, Month, Day, Hours, Minutes, Seconds, Nanos int32
+}
+
+func (p *protoDateTime) GetYear() int32    { return p.get().Year }
+func (p *protoDateTime) GetMonth() int32   { return p.get().Month }
+func (p *protoDateTime) GetDay() int32     { return p.get().Day }
+func (p *protoDateTime) GetHours() int32   { return p.get().Hours }
+func (p *protoDateTime) GetMinutes() int32 { return p.get().Minutes }
+func (p *protoDateTime) GetSeconds() int32 { return p.get().Seconds }
+func (p *protoDateTime) GetNanos() int32   { return p.get().Nanos }
+
+func (p *protoDateTime) get() protoDateTime {
+	if p == nil {
+		return protoDateTime{}
+	}
+	return *p
+}
+
+func TestProto_RoundTrip(t *testing.T) {
+	dt := DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876}}
+	var p protoDateTime
+	p.Year, p.Month, p.Day, p.Hours, p.Minutes, p.Seconds, p.Nanos = dt.ProtoFields()
+	assert.Equal(t, protoDateTime{2020, 2, 29, 3, 42, 31, 876}, p)
+
+	dt0, err := DateTimeFromProto(&p)
+	assert.NoError(t, err)
+	assert.Equal(t, dt, dt0)
+
+	d, err := DateFromProto(&p)
+	assert.NoError(t, err)
+	assert.Equal(t, dt.Date, d)
+
+	tm, err := TimeFromProto(&p)
+	assert.NoError(t, err)
+	assert.Equal(t, dt.Time, tm)
+
+	d, err = DateFromProto((*protoDateTime)(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, Date{}, d)
+}
+
+/* === ERRORS === */
+
+func TestProto_Errors(t *testing.T) {
+	type TC struct {
+		In  protoDateTime
+		Err error
+	}
+	tcs := []TC{
+		TC{In: protoDateTime{Month: 2, Day: 29}, Err: ErrInvalidFormat},
+		TC{In: protoDateTime{Year: 2020}, Err: ErrInvalidFormat},
+		TC{In: protoDateTime{Year: 2021, Month: 2, Day: 29}, Err: ErrInvalidDay},
+		TC{In: protoDateTime{Year: 2020, Month: 2, Day: 29, Hours: 24}, Err: ErrInvalidTime},
+		TC{In: protoDateTime{Year: 2020, Month: 2, Day: 29, Seconds: 60}, Err: ErrInvalidTime},
+		TC{In: protoDateTime{Hours: 3}, Err: ErrInvalidFormat},
+	}
+	for _, tc := range tcs {
+		_, err := DateTimeFromProto(&tc.In)
+		assert.True(t, errors.Is(err, tc.Err), "%v: %v", tc.In, err)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// A Quarter is one of the four three-month quarters of a calendar year,
+// starting in January, April, July and October.
+type Quarter struct {
+	Year int // Year (e.g., 2014).
+	Q    int // Quarter of the year; range [1-4].
+}
+
+// Quarter returns the calendar quarter containing d.
+func (d Date) Quarter() Quarter {
+	return Quarter{Year: d.Year, Q: (int(d.Month)-1)/3 + 1}
+}
+
+// ParseQuarter parses a string in "YYYY-Qn" format, such as "2024-Q3", and
+// returns the quarter it represents.
+func ParseQuarter(s string) (Quarter, error) {
+	if len(s) != len("2006-Q1") || s[4] != '-' || s[5] != 'Q' {
+		return Quarter{}, fmt.Errorf("%w: %q is not a quarter in YYYY-Qn format", ErrInvalidFormat, s)
+	}
+	year, err := parseDigits(s[:4])
+	if err != nil {
+		return Quarter{}, fmt.Errorf("%w: invalid year in quarter %q", ErrInvalidFormat, s)
+	}
+	q := int(s[6] - '0')
+	if q < 1 || q > 4 {
+		return Quarter{}, fmt.Errorf("%w: invalid quarter in %q", ErrInvalidFormat, s)
+	}
+	return Quarter{Year: year, Q: q}, nil
+}
+
+// String returns the quarter in "YYYY-Qn" format, such as "2024-Q3".
+func (q Quarter) String() string {
+	return string(q.appendString(make([]byte, 0, len("2006-Q1"))))
+}
+
+func (q Quarter) appendString(b []byte) []byte {
+	b = appendInt(b, q.Year, 4, '0')
+	b = append(b, "-Q"...)
+	return appendInt(b, q.Q, 1, '0')
+}
+
+// IsValid reports whether the quarter is one of Q1 to Q4.
+func (q Quarter) IsValid() bool {
+	return q.Q >= 1 && q.Q <= 4
+}
+
+// Start returns the first day of the quarter.
+func (q Quarter) Start() Date {
+	return Date{Year: q.Year, Month: time.Month(q.Q*3 - 2), Day: 1}
+}
+
+// End returns the last day of the quarter.
+func (q Quarter) End() Date {
+	month := time.Month(q.Q * 3)
+	return Date{Year: q.Year, Month: month, Day: daysIn(month, q.Year)}
+}
+
+// Contains reports whether d falls within the quarter.
+func (q Quarter) Contains(d Date) bool {
+	return d.Quarter() == q
+}
+
+// Next returns the quarter after q, which is Q1 of the next year after Q4.
+func (q Quarter) Next() Quarter {
+	if q.Q >= 4 {
+		return Quarter{Year: q.Year + 1, Q: 1}
+	}
+	return Quarter{Year: q.Year, Q: q.Q + 1}
+}
+
+// Prev returns the quarter before q, which is Q4 of the previous year before
+// Q1.
+func (q Quarter) Prev() Quarter {
+	if q.Q <= 1 {
+		return Quarter{Year: q.Year - 1, Q: 4}
+	}
+	return Quarter{Year: q.Year, Q: q.Q - 1}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The output is the result of q.String().
+func (q Quarter) MarshalText() ([]byte, error) {
+	return q.appendString(nil), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The quarter is expected in a form accepted by ParseQuarter.
+func (q *Quarter) UnmarshalText(data []byte) error {
+	val, err := ParseQuarter(string(data))
+	if err != nil {
+		return err
+	}
+	*q = val
+	return nil
+}
+
+// MarshalJSON implements the encoding/json Marshaler interface. The quarter
+// is written as a string in the form of String.
+func (q Quarter) MarshalJSON() ([]byte, error) {
+	b := append(make([]byte, 0, len(`"2006-Q1"`)), '"')
+	b = q.appendString(b)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements the encoding/json Unmarshaler interface. The
+// JSON null value leaves the quarter unchanged.
+func (q *Quarter) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%w: quarter should be a string, got %s", ErrInvalidFormat, data)
+	}
+	return q.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,98 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_Quarter(t *testing.T) {
+	type TC struct {
+		In    Date
+		Out   Quarter
+		Start Date
+		End   Date
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 2, 29}, Out: Quarter{2020, 1}, Start: Date{2020, 1, 1}, End: Date{2020, 3, 31}},
+		TC{In: Date{2020, 4, 1}, Out: Quarter{2020, 2}, Start: Date{2020, 4, 1}, End: Date{2020, 6, 30}},
+		TC{In: Date{2020, 9, 30}, Out: Quarter{2020, 3}, Start: Date{2020, 7, 1}, End: Date{2020, 9, 30}},
+		TC{In: Date{2020, 12, 31}, Out: Quarter{2020, 4}, Start: Date{2020, 10, 1}, End: Date{2020, 12, 31}},
+	}
+	for _, tc := range tcs {
+		q := tc.In.Quarter()
+		assert.Equal(t, tc.Out, q, "%v", tc.In)
+		assert.Equal(t, tc.Start, q.Start(), "%v", tc.In)
+		assert.Equal(t, tc.End, q.End(), "%v", tc.In)
+		assert.True(t, q.Contains(tc.In), "%v", tc.In)
+		assert.False(t, q.Contains(q.End().AddDays(1)), "%v", tc.In)
+		assert.False(t, q.Contains(q.Start().AddDays(-1)), "%v", tc.In)
+	}
+}
+
+func TestQuarter_NextPrev(t *testing.T) {
+	assert.Equal(t, Quarter{2020, 2}, Quarter{2020, 1}.Next())
+	assert.Equal(t, Quarter{2021, 1}, Quarter{2020, 4}.Next())
+	assert.Equal(t, Quarter{2020, 3}, Quarter{2020, 4}.Prev())
+	assert.Equal(t, Quarter{2019, 4}, Quarter{2020, 1}.Prev())
+}
+
+func TestQuarter_RoundTrip(t *testing.T) {
+	type TC struct {
+		In  Quarter
+		Out string
+	}
+	tcs := []TC{
+		TC{In: Quarter{2024, 3}, Out: "2024-Q3"},
+		TC{In: Quarter{2020, 1}, Out: "2020-Q1"},
+		TC{In: Quarter{987, 4}, Out: "0987-Q4"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.String())
+
+		q, err := ParseQuarter(tc.Out)
+		assert.NoError(t, err, tc.Out)
+		assert.Equal(t, tc.In, q, tc.Out)
+
+		data, err := json.Marshal(tc.In)
+		assert.NoError(t, err)
+		assert.Equal(t, `"`+tc.Out+`"`, string(data))
+
+		var got Quarter
+		assert.NoError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, tc.In, got)
+	}
+
+	q := Quarter{2020, 2}
+	assert.NoError(t, json.Unmarshal([]byte("null"), &q))
+	assert.Equal(t, Quarter{2020, 2}, q)
+}
+
+/* === ERRORS === */
+
+func TestParseQuarter_Errors(t *testing.T) {
+	for _, s := range []string{"", "2024-Q0", "2024-Q5", "2024Q3", "2024-q3", "2024-3", "20x4-Q3", "2024-Q33"} {
+		_, err := ParseQuarter(s)
+		assert.True(t, errors.Is(err, ErrInvalidFormat), s)
+	}
+
+	var q Quarter
+	assert.Error(t, json.Unmarshal([]byte("20243"), &q))
+	assert.Error(t, json.Unmarshal([]byte(`"2024-Q9"`), &q))
+}
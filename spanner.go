@@ -0,0 +1,80 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// The EncodeSpanner and DecodeSpanner methods implement the Encoder and
+// Decoder interfaces of cloud.google.com/go/spanner without importing it,
+// so that Date and DateTime can be used directly in mutations, row
+// decoding and ToStruct. A Date maps to a DATE column and a DateTime to a
+// TIMESTAMP column, read and written in UTC. Spanner has no type matching
+// Time. A NULL is an error wrapping ErrNull, as in Scan; decode nullable
+// columns into a spanner.NullDate or spanner.NullTime.
+
+// EncodeSpanner returns the date as a "2006-01-02" string, which Spanner
+// stores into DATE columns. In queries, compare a DATE column with
+// CAST(@param AS DATE), since the parameter is typed as a STRING.
+func (d Date) EncodeSpanner() (interface{}, error) {
+	return d.String(), nil
+}
+
+// DecodeSpanner decodes a Spanner DATE value, which the client passes as
+// a string.
+func (d *Date) DecodeSpanner(input interface{}) error {
+	var val Date
+	switch v := input.(type) {
+	case nil:
+		return fmt.Errorf("%w: cannot decode NULL into Date", ErrNull)
+	case string:
+		var err error
+		if val, err = ParseDate(v); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: cannot decode %T into Date", ErrUnsupportedType, input)
+	}
+	*d = val
+	return nil
+}
+
+// EncodeSpanner returns the datetime as a time.Time in UTC, which Spanner
+// stores into TIMESTAMP columns.
+func (dt DateTime) EncodeSpanner() (interface{}, error) {
+	return dt.In(time.UTC), nil
+}
+
+// DecodeSpanner decodes a Spanner TIMESTAMP value, which the client passes
+// as an RFC 3339 string, at its time in UTC.
+func (dt *DateTime) DecodeSpanner(input interface{}) error {
+	var val DateTime
+	switch v := input.(type) {
+	case nil:
+		return fmt.Errorf("%w: cannot decode NULL into DateTime", ErrNull)
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return fmt.Errorf("%w: %q is not a Spanner timestamp", ErrInvalidFormat, v)
+		}
+		val = DateTimeOf(t.UTC())
+	default:
+		return fmt.Errorf("%w: cannot decode %T into DateTime", ErrUnsupportedType, input)
+	}
+	*dt = val
+	return nil
+}
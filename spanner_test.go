@@ -0,0 +1,88 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// spannerEncoder and spannerDecoder mirror the interfaces of
+// cloud.google.com/go/spanner.
+type spannerEncoder interface {
+	EncodeSpanner() (interface{}, error)
+}
+
+type spannerDecoder interface {
+	DecodeSpanner(input interface{}) error
+}
+
+var (
+	_ spannerEncoder = Date{}
+	_ spannerEncoder = DateTime{}
+	_ spannerDecoder = (*Date)(nil)
+	_ spannerDecoder = (*DateTime)(nil)
+)
+
+func TestDate_Spanner(t *testing.T) {
+	v, err := Date{2020, 2, 29}.EncodeSpanner()
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-02-29", v)
+
+	var d Date
+	assert.NoError(t, d.DecodeSpanner(v))
+	assert.Equal(t, Date{2020, 2, 29}, d)
+}
+
+func TestDateTime_Spanner(t *testing.T) {
+	dt := DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 123456789}}
+	v, err := dt.EncodeSpanner()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 2, 29, 3, 42, 31, 123456789, time.UTC), v)
+
+	type TC struct {
+		In  string
+		Out DateTime
+	}
+	tcs := []TC{
+		TC{In: "2020-02-29T03:42:31.123456789Z", Out: dt},
+		TC{In: "2020-02-29T03:42:31Z", Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}},
+		TC{In: "2020-02-29T01:42:31-02:00", Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}},
+	}
+	for _, tc := range tcs {
+		var dt0 DateTime
+		assert.NoError(t, dt0.DecodeSpanner(tc.In), tc.In)
+		assert.Equal(t, tc.Out, dt0, tc.In)
+	}
+}
+
+/* === ERRORS === */
+
+func TestSpanner_Errors(t *testing.T) {
+	d := Date{2020, 2, 29}
+	assert.True(t, errors.Is(d.DecodeSpanner(nil), ErrNull))
+	assert.True(t, errors.Is(d.DecodeSpanner(int64(18321)), ErrUnsupportedType))
+	assert.Error(t, d.DecodeSpanner("2020-02-30"))
+	assert.Equal(t, Date{2020, 2, 29}, d)
+
+	dt := DateTime{Date: Date{2020, 2, 29}}
+	assert.True(t, errors.Is(dt.DecodeSpanner(nil), ErrNull))
+	assert.True(t, errors.Is(dt.DecodeSpanner(time.Now()), ErrUnsupportedType))
+	assert.True(t, errors.Is(dt.DecodeSpanner("2020-02-29T03:42:31"), ErrInvalidFormat))
+	assert.Equal(t, DateTime{Date: Date{2020, 2, 29}}, dt)
+}
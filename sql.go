@@ -0,0 +1,339 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SQLOptions controls how Date, Time and DateTime values are exchanged with
+// database/sql drivers. The Scan and Value methods of the types themselves
+// always follow the zero value; Column applies other options to a single
+// column:
+//
+//	opts := civil.SQLOptions{NullAsZero: true, EpochDays: true}
+//	err := row.Scan(opts.Column(&d))
+//	_, err = db.Exec("UPDATE t SET d = ?", opts.Column(d))
+type SQLOptions struct {
+	// NullAsZero makes Scan store the zero value for a SQL NULL instead of
+	// returning an error wrapping ErrNull. A NULL then cannot be told apart
+	// from a zero value; NullDate, NullTime and NullDateTime keep the
+	// distinction.
+	NullAsZero bool
+
+	// EpochDays stores a Date as an int64 number of days since 1970-01-01,
+	// the representation of ClickHouse Date columns, Avro-backed warehouses
+	// and Debezium payloads: a Date column writes it and accepts it in
+	// addition to the usual types. Time and DateTime are not affected.
+	EpochDays bool
+
+	// TimeValues makes Value return a time.Time in UTC, which drivers such
+	// as pgx in binary mode and go-mssqldb handle better than strings: a
+	// Date at midnight and a DateTime at its wall-clock time. EpochDays
+	// takes precedence for Date, and a Time is still written as a string,
+	// since SQL has no standard mapping of time.Time to TIME.
+	TimeValues bool
+
+	// Dialect adapts Scan and Value to the conventions of a database. For
+	// SQLite, which has no date and time types, store strings with the
+	// default dialect, or use SQLiteJulianDay or SQLiteUnixTime columns.
+	Dialect SQLDialect
+
+	// Parse relaxes the formats of the strings Scan accepts, as for the
+	// Parse methods of ParseOptions. Under DialectOracle, its
+	// TwoDigitYearStart also places two-digit years.
+	Parse ParseOptions
+
+	// Infinity selects how Scan handles the PostgreSQL date and timestamp
+	// values 'infinity' and '-infinity'. The zero value rejects them.
+	Infinity InfinityPolicy
+}
+
+// Column returns an adapter that scans into or writes v following o. To
+// scan, v must be a *Date, *Time, *DateTime, *NullDate, *NullTime,
+// *NullDateTime or *Period; to write, v may also be one of those types
+// itself.
+func (o SQLOptions) Column(v interface{}) SQLColumn {
+	return SQLColumn{v: v, opts: o}
+}
+
+// SQLColumn is a sql.Scanner and driver.Valuer, returned by
+// SQLOptions.Column, that scans into or writes a civil value following a
+// set of SQLOptions.
+type SQLColumn struct {
+	v    interface{}
+	opts SQLOptions
+}
+
+// Scan implements the database/sql scanner interface.
+func (c SQLColumn) Scan(value interface{}) error {
+	switch v := c.v.(type) {
+	case *Date:
+		return v.scan(c.opts, value)
+	case *Time:
+		return v.scan(c.opts, value)
+	case *DateTime:
+		return v.scan(c.opts, value)
+	case *NullDate:
+		return v.scan(c.opts, value)
+	case *NullTime:
+		return v.scan(c.opts, value)
+	case *NullDateTime:
+		return v.scan(c.opts, value)
+	case *Period:
+		return v.scan(c.opts, value)
+	}
+	return fmt.Errorf("%w: cannot scan into %T", ErrUnsupportedType, c.v)
+}
+
+// Value implements the database/sql/driver valuer interface. A nil pointer
+// or an invalid Null type is written as NULL.
+func (c SQLColumn) Value() (driver.Value, error) {
+	switch v := c.v.(type) {
+	case *Date:
+		if v != nil {
+			return v.value(c.opts)
+		}
+	case *Time:
+		if v != nil {
+			return v.value(c.opts)
+		}
+	case *DateTime:
+		if v != nil {
+			return v.value(c.opts)
+		}
+	case *NullDate:
+		if v != nil {
+			return v.value(c.opts)
+		}
+	case *NullTime:
+		if v != nil {
+			return v.value(c.opts)
+		}
+	case *NullDateTime:
+		if v != nil {
+			return v.value(c.opts)
+		}
+	case *Period:
+		if v != nil {
+			return v.Value()
+		}
+	case Date:
+		return v.value(c.opts)
+	case Time:
+		return v.value(c.opts)
+	case DateTime:
+		return v.value(c.opts)
+	case NullDate:
+		return v.value(c.opts)
+	case NullTime:
+		return v.value(c.opts)
+	case NullDateTime:
+		return v.value(c.opts)
+	case Period:
+		return v.Value()
+	default:
+		return nil, fmt.Errorf("%w: cannot write %T", ErrUnsupportedType, c.v)
+	}
+	return nil, nil
+}
+
+// InfinityPolicy selects how Scan handles the PostgreSQL date and timestamp
+// values 'infinity' and '-infinity'.
+type InfinityPolicy int
+
+const (
+	// InfinityReject returns an error wrapping ErrYearOutOfRange.
+	InfinityReject InfinityPolicy = iota
+
+	// InfinityClamp scans 'infinity' as MaxDate() or MaxDateTime() and
+	// '-infinity' as MinDate() or MinDateTime(), so as the ends of the
+	// range [MinYear,MaxYear].
+	InfinityClamp
+
+	// InfinityNull scans both as a SQL NULL, so that a NullDate or
+	// NullDateTime is not Valid. A Date or DateTime then follows NullAsZero.
+	InfinityNull
+)
+
+// MaxDate returns the last date of the range of years [MinYear,MaxYear].
+func MaxDate() Date {
+	return Date{Year: MaxYear, Month: time.December, Day: 31}
+}
+
+// MinDate returns the first date of the range of years [MinYear,MaxYear].
+func MinDate() Date {
+	return Date{Year: MinYear, Month: time.January, Day: 1}
+}
+
+// MaxDateTime returns the last instant of MaxDate.
+func MaxDateTime() DateTime {
+	return DateTime{Date: MaxDate(), Time: Time{Hour: 23, Minute: 59, Second: 59, Nanosecond: 999999999}}
+}
+
+// MinDateTime returns the first instant of MinDate.
+func MinDateTime() DateTime {
+	return DateTime{Date: MinDate()}
+}
+
+// infinitySign returns 1 if value is the string 'infinity', -1 if it is
+// '-infinity', and 0 otherwise.
+func infinitySign(value interface{}) int {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	}
+	switch s {
+	case "infinity":
+		return 1
+	case "-infinity":
+		return -1
+	}
+	return 0
+}
+
+// scansAsNull reports whether Scan treats value as a SQL NULL.
+func (o SQLOptions) scansAsNull(value interface{}) bool {
+	return timeSource(value) == nil || (o.Infinity == InfinityNull && infinitySign(value) != 0)
+}
+
+// timeSource unwraps the *time.Time and sql.NullTime values that some
+// row-mapping layers pass to Scan, returning a time.Time or, for a nil
+// pointer or an invalid NullTime, nil. Other values are returned as is.
+func timeSource(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case sql.NullTime:
+		if !v.Valid {
+			return nil
+		}
+		return v.Time
+	}
+	return value
+}
+
+// scanTypes are the Go types that Scan accepts for Date, Time and DateTime
+// under any options.
+var scanTypes = []string{"string", "[]byte", "time.Time", "*time.Time", "sql.NullTime"}
+
+// errScanType returns the error for a value of a type that cannot be
+// scanned into typeName, listing scanTypes and any extra types accepted.
+func errScanType(value interface{}, typeName string, extra ...string) error {
+	want := append(append([]string(nil), scanTypes...), extra...)
+	last := len(want) - 1
+	return fmt.Errorf("%w: cannot scan %T into %s; want %s or %s", ErrUnsupportedType, value, typeName, strings.Join(want[:last], ", "), want[last])
+}
+
+// SQLDialect selects the database conventions followed by the Scan and
+// Value methods of an SQLOptions column.
+type SQLDialect int
+
+const (
+	// DialectStandard writes Time and DateTime values in the formats of
+	// their String methods.
+	DialectStandard SQLDialect = iota
+
+	// DialectMySQL follows MySQL. Value writes a DateTime in the literal
+	// form "2006-01-02 15:04:05.999999" and a Time with at most six
+	// fractional digits, truncated, so that DATETIME(6) and TIME(6) columns
+	// store them without rounding. Scan takes a zero time.Time, which the
+	// MySQL driver returns for "0000-00-00 00:00:00" when parseTime is set,
+	// as the zero value. The string form of the zero date is accepted in
+	// any dialect unless Parse.Strict is set.
+	DialectMySQL
+
+	// DialectSQLServer follows SQL Server, whose DATETIME2 and TIME columns
+	// count in 100ns ticks and reject literals with more than seven
+	// fractional digits. Value writes a DateTime in the form
+	// "2006-01-02 15:04:05.9999999" and a Time with exactly seven
+	// fractional digits, truncated. Scan accepts the seven-digit strings
+	// go-mssqldb returns for these columns in any dialect.
+	DialectSQLServer
+
+	// DialectOracle follows Oracle, whose DATE type holds a date and a time
+	// to the second, and whose TIMESTAMP adds fractional seconds. Scan
+	// either into a DateTime; scanning one into a Date keeps the date and
+	// drops the time. Oracle has no type matching Time. Besides the usual
+	// formats, Scan accepts the default NLS formats "DD-MON-RR" and
+	// "DD-MON-RR HH.MI.SSXFF AM" and their four-digit-year forms, with
+	// two-digit years placed by Parse.TwoDigitYearStart, and accepts
+	// a datetime string for a Date. The time.Time values from godror are
+	// read at their wall-clock time, as in any dialect. Value is unchanged;
+	// set TimeValues, since Oracle converts strings using the session's NLS
+	// formats.
+	DialectOracle
+)
+
+// sqlServerPrecision is the precision of SQL Server DATETIME2 and TIME.
+const sqlServerPrecision Precision = 7
+
+// valuePrecision returns the precision used by Value for Time and DateTime
+// strings, and whether the dialect limits the fractional digits at all.
+func (o SQLOptions) valuePrecision() (Precision, bool) {
+	switch o.Dialect {
+	case DialectMySQL:
+		return PrecisionMicros, true
+	case DialectSQLServer:
+		return sqlServerPrecision, true
+	}
+	return PrecisionAuto, false
+}
+
+// parseDate parses a string scanned into a Date.
+func (o SQLOptions) parseDate(s string) (Date, error) {
+	if o.Dialect == DialectOracle {
+		dt, err := o.Parse.decoding().parseOracle(s)
+		return dt.Date, err
+	}
+	return o.Parse.decoding().ParseDate(s)
+}
+
+// parseDateTime parses a string scanned into a DateTime, always allowing
+// the space separator of SQL literals.
+func (o SQLOptions) parseDateTime(s string) (DateTime, error) {
+	if o.Dialect == DialectOracle {
+		return o.Parse.decoding().parseOracle(s)
+	}
+	opts := o.Parse.decoding()
+	opts.SpaceSeparator = true
+	return opts.ParseDateTime(s)
+}
+
+// scanParseError wraps err, from parsing the string or []byte value scanned
+// into typeName, with the Go type received and the formats expected.
+func (o SQLOptions) scanParseError(value interface{}, typeName, layout string, err error) error {
+	want := strconv.Quote(layout)
+	if o.Dialect == DialectOracle && typeName != "Time" {
+		want += " or an Oracle NLS format"
+	}
+	return fmt.Errorf("civil: cannot scan %T into %s, want %s: %w", value, typeName, want, err)
+}
+
+// scanZero reports whether Scan should take t as the zero value.
+func (o SQLOptions) scanZero(t time.Time) bool {
+	return o.Dialect == DialectMySQL && t.IsZero()
+}
@@ -0,0 +1,446 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScan_NullAsZero(t *testing.T) {
+	opts := SQLOptions{NullAsZero: true}
+
+	d := Date{2020, 2, 29}
+	assert.NoError(t, opts.Column(&d).Scan(nil))
+	assert.Equal(t, Date{}, d)
+
+	tm := Time{3, 42, 31, 0}
+	assert.NoError(t, opts.Column(&tm).Scan(nil))
+	assert.Equal(t, Time{}, tm)
+
+	dt := DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}
+	assert.NoError(t, opts.Column(&dt).Scan(nil))
+	assert.Equal(t, DateTime{}, dt)
+
+	p := Period{Years: 1}
+	assert.NoError(t, opts.Column(&p).Scan(nil))
+	assert.Equal(t, Period{}, p)
+
+	// The methods of the types themselves are unaffected.
+	assert.True(t, errors.Is(d.Scan(nil), ErrNull))
+}
+
+func TestDate_EpochDays(t *testing.T) {
+	opts := SQLOptions{EpochDays: true}
+
+	d := Date{2020, 2, 29}
+	v, err := opts.Column(d).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(18321), v)
+
+	var d0 Date
+	assert.NoError(t, opts.Column(&d0).Scan(int64(18321)))
+	assert.Equal(t, d, d0)
+	assert.NoError(t, opts.Column(&d0).Scan(int64(-1)))
+	assert.Equal(t, Date{1969, 12, 31}, d0)
+
+	// Strings are still accepted.
+	assert.NoError(t, opts.Column(&d0).Scan("2020-02-29"))
+	assert.Equal(t, d, d0)
+
+	vt, err := opts.Column(Time{Hour: 9}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "09:00:00", vt)
+}
+
+func TestValue_TimeValues(t *testing.T) {
+	opts := SQLOptions{TimeValues: true}
+
+	v, err := opts.Column(Date{2020, 2, 29}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), v)
+
+	dt := DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876}}
+	v, err = opts.Column(&dt).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 2, 29, 3, 42, 31, 876, time.UTC), v)
+
+	// Scanning the value back gives the same datetime.
+	var dt0 DateTime
+	assert.NoError(t, dt0.Scan(v))
+	assert.Equal(t, dt, dt0)
+
+	v, err = opts.Column(Time{Hour: 9}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "09:00:00", v)
+
+	opts.EpochDays = true
+	v, err = opts.Column(Date{2020, 2, 29}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(18321), v)
+}
+
+func TestDialectMySQL(t *testing.T) {
+	opts := SQLOptions{Dialect: DialectMySQL}
+
+	type TC struct {
+		In  driver.Valuer
+		Out driver.Value
+	}
+	tcs := []TC{
+		TC{In: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876543210}}, Out: "2020-02-29 03:42:31.876543"},
+		TC{In: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}, Out: "2020-02-29 03:42:31.000000"},
+		TC{In: Time{3, 42, 31, 876543210}, Out: "03:42:31.876543"},
+		TC{In: Date{2020, 2, 29}, Out: "2020-02-29"},
+	}
+	for _, tc := range tcs {
+		v, err := opts.Column(tc.In).Value()
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, v, tc.In)
+	}
+
+	// Values round-trip at microsecond precision.
+	dt := DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876543000}}
+	v, err := opts.Column(dt).Value()
+	assert.NoError(t, err)
+	var dt0 DateTime
+	assert.NoError(t, opts.Column(&dt0).Scan(v))
+	assert.Equal(t, dt, dt0)
+}
+
+func TestDialectMySQL_ZeroDate(t *testing.T) {
+	opts := SQLOptions{Dialect: DialectMySQL}
+
+	dt := DateTime{Date{2020, 2, 29}, Time{Hour: 3}}
+	assert.NoError(t, opts.Column(&dt).Scan("0000-00-00 00:00:00"))
+	assert.Equal(t, DateTime{}, dt)
+
+	dt = DateTime{Date{2020, 2, 29}, Time{Hour: 3}}
+	assert.NoError(t, opts.Column(&dt).Scan([]byte("0000-00-00 00:00:00.000000")))
+	assert.Equal(t, DateTime{}, dt)
+
+	d := Date{2020, 2, 29}
+	assert.NoError(t, opts.Column(&d).Scan("0000-00-00"))
+	assert.Equal(t, Date{}, d)
+
+	// The driver reports the zero date as a zero time.Time with parseTime.
+	dt = DateTime{Date{2020, 2, 29}, Time{Hour: 3}}
+	assert.NoError(t, opts.Column(&dt).Scan(time.Time{}))
+	assert.Equal(t, DateTime{}, dt)
+
+	d = Date{2020, 2, 29}
+	assert.NoError(t, opts.Column(&d).Scan(time.Time{}))
+	assert.Equal(t, Date{}, d)
+
+	// Without the dialect a zero time.Time is January 1 of year 1.
+	assert.NoError(t, d.Scan(time.Time{}))
+	assert.Equal(t, Date{1, 1, 1}, d)
+}
+
+func TestDialectSQLServer(t *testing.T) {
+	opts := SQLOptions{Dialect: DialectSQLServer}
+
+	type TC struct {
+		In  driver.Valuer
+		Out driver.Value
+	}
+	tcs := []TC{
+		TC{In: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876543219}}, Out: "2020-02-29 03:42:31.8765432"},
+		TC{In: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}, Out: "2020-02-29 03:42:31.0000000"},
+		TC{In: Time{3, 42, 31, 876543219}, Out: "03:42:31.8765432"},
+		TC{In: Date{2020, 2, 29}, Out: "2020-02-29"},
+	}
+	for _, tc := range tcs {
+		v, err := opts.Column(tc.In).Value()
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, v, tc.In)
+	}
+
+	// Seven-digit strings from go-mssqldb scan in full and round-trip.
+	var dt DateTime
+	assert.NoError(t, opts.Column(&dt).Scan([]byte("2020-02-29 03:42:31.8765432")))
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876543200}}, dt)
+	v, err := opts.Column(&dt).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-02-29 03:42:31.8765432", v)
+
+	var tm Time
+	assert.NoError(t, opts.Column(&tm).Scan("03:42:31.8765432"))
+	assert.Equal(t, Time{3, 42, 31, 876543200}, tm)
+	v, err = opts.Column(tm).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "03:42:31.8765432", v)
+}
+
+func TestScan_Infinity(t *testing.T) {
+	opts := SQLOptions{Infinity: InfinityClamp}
+	var d Date
+	assert.NoError(t, opts.Column(&d).Scan("infinity"))
+	assert.Equal(t, MaxDate(), d)
+	assert.NoError(t, opts.Column(&d).Scan([]byte("-infinity")))
+	assert.Equal(t, MinDate(), d)
+	var dt DateTime
+	assert.NoError(t, opts.Column(&dt).Scan("infinity"))
+	assert.Equal(t, MaxDateTime(), dt)
+	assert.NoError(t, opts.Column(&dt).Scan("-infinity"))
+	assert.Equal(t, MinDateTime(), dt)
+	var nd NullDate
+	assert.NoError(t, opts.Column(&nd).Scan("infinity"))
+	assert.Equal(t, NullDate{Date: MaxDate(), Valid: true}, nd)
+
+	opts.Infinity = InfinityNull
+	assert.NoError(t, opts.Column(&nd).Scan("infinity"))
+	assert.Equal(t, NullDate{}, nd)
+	ndt := NullDateTime{Valid: true}
+	assert.NoError(t, opts.Column(&ndt).Scan([]byte("-infinity")))
+	assert.Equal(t, NullDateTime{}, ndt)
+	assert.True(t, errors.Is(opts.Column(&d).Scan("infinity"), ErrNull))
+
+	opts.NullAsZero = true
+	d = Date{2020, 2, 29}
+	assert.NoError(t, opts.Column(&d).Scan("infinity"))
+	assert.Equal(t, Date{}, d)
+}
+
+func TestScan_Infinity_YearRange(t *testing.T) {
+	defer func(min, max int) { MinYear, MaxYear = min, max }(MinYear, MaxYear)
+	MinYear, MaxYear = -9999, 99999
+	opts := SQLOptions{Infinity: InfinityClamp}
+
+	var d Date
+	assert.NoError(t, opts.Column(&d).Scan("infinity"))
+	assert.Equal(t, Date{99999, 12, 31}, d)
+	var dt DateTime
+	assert.NoError(t, opts.Column(&dt).Scan("-infinity"))
+	assert.Equal(t, DateTime{Date: Date{-9999, 1, 1}}, dt)
+	assert.Equal(t, DateTime{Date{99999, 12, 31}, Time{23, 59, 59, 999999999}}, MaxDateTime())
+}
+
+func TestScan_TimeSources(t *testing.T) {
+	tt := time.Date(2020, 2, 29, 3, 42, 31, 876, time.UTC)
+	for _, v := range []interface{}{&tt, sql.NullTime{Time: tt, Valid: true}} {
+		var d Date
+		assert.NoError(t, d.Scan(v))
+		assert.Equal(t, Date{2020, 2, 29}, d)
+
+		var tm Time
+		assert.NoError(t, tm.Scan(v))
+		assert.Equal(t, Time{3, 42, 31, 876}, tm)
+
+		var dt DateTime
+		assert.NoError(t, dt.Scan(v))
+		assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 876}}, dt)
+
+		var nd NullDate
+		assert.NoError(t, nd.Scan(v))
+		assert.Equal(t, NullDate{Date{2020, 2, 29}, true}, nd)
+	}
+
+	// A nil *time.Time and an invalid sql.NullTime are NULL.
+	for _, v := range []interface{}{(*time.Time)(nil), sql.NullTime{}} {
+		nd := NullDate{Valid: true}
+		assert.NoError(t, nd.Scan(v))
+		assert.False(t, nd.Valid)
+
+		nt := NullTime{Valid: true}
+		assert.NoError(t, nt.Scan(v))
+		assert.False(t, nt.Valid)
+
+		ndt := NullDateTime{Valid: true}
+		assert.NoError(t, ndt.Scan(v))
+		assert.False(t, ndt.Valid)
+
+		var d Date
+		assert.True(t, errors.Is(d.Scan(v), ErrNull))
+		var tm Time
+		assert.True(t, errors.Is(tm.Scan(v), ErrNull))
+		var dt DateTime
+		assert.True(t, errors.Is(dt.Scan(v), ErrNull))
+	}
+}
+
+func TestSQLColumn_Value(t *testing.T) {
+	opts := SQLOptions{EpochDays: true}
+
+	type TC struct {
+		In  interface{}
+		Out driver.Value
+	}
+	d := Date{2020, 2, 29}
+	tcs := []TC{
+		TC{In: d, Out: int64(18321)},
+		TC{In: &d, Out: int64(18321)},
+		TC{In: (*Date)(nil), Out: nil},
+		TC{In: NullDate{Date: d, Valid: true}, Out: int64(18321)},
+		TC{In: NullDate{}, Out: nil},
+		TC{In: &NullTime{Time: Time{Hour: 9}, Valid: true}, Out: "09:00:00"},
+		TC{In: NullDateTime{DateTime: DateTime{Date: d}, Valid: true}, Out: "2020-02-29T00:00:00"},
+		TC{In: Period{Days: 3}, Out: "P3D"},
+	}
+	for _, tc := range tcs {
+		v, err := opts.Column(tc.In).Value()
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, v, tc.In)
+	}
+}
+
+func TestGormDataType(t *testing.T) {
+	assert.Equal(t, "DATE", Date{}.GormDataType())
+	assert.Equal(t, "TIME", Time{}.GormDataType())
+	assert.Equal(t, "TIMESTAMP", DateTime{}.GormDataType())
+}
+
+/* === ERRORS === */
+
+func TestScan_Null(t *testing.T) {
+	d := Date{2020, 2, 29}
+	err := d.Scan(nil)
+	assert.True(t, errors.Is(err, ErrNull))
+	assert.EqualError(t, err, "civil: NULL value: cannot scan NULL into Date; use NullDate")
+	assert.Equal(t, Date{2020, 2, 29}, d)
+
+	var tm Time
+	assert.True(t, errors.Is(tm.Scan(nil), ErrNull))
+	var dt DateTime
+	assert.True(t, errors.Is(dt.Scan(nil), ErrNull))
+}
+
+func TestScan_UnsupportedTypes(t *testing.T) {
+	s := "2020-02-29"
+	values := []interface{}{
+		true,
+		int(18321),
+		int32(18321),
+		uint64(18321),
+		float32(1.5),
+		complex(1, 2),
+		&s,
+		time.UTC,
+		[]string{"2020-02-29"},
+		map[string]int{"day": 29},
+		struct{}{},
+		time.Second,
+		Date{2020, 2, 29},
+		Time{Hour: 3},
+		DateTime{},
+	}
+	scanners := map[string]sql.Scanner{
+		"Date":     &Date{},
+		"Time":     &Time{},
+		"DateTime": &DateTime{},
+	}
+	for name, sc := range scanners {
+		for _, v := range values {
+			err := sc.Scan(v)
+			msg := fmt.Sprintf("civil: unsupported type: cannot scan %T into %s; want string, []byte, time.Time, *time.Time or sql.NullTime", v, name)
+			assert.True(t, errors.Is(err, ErrUnsupportedType), msg)
+			assert.EqualError(t, err, msg)
+		}
+	}
+
+	// Integer and floating-point values need an option that gives them a
+	// meaning.
+	for name, sc := range scanners {
+		for _, v := range []interface{}{int64(18321), float64(2458908.5)} {
+			err := sc.Scan(v)
+			assert.True(t, errors.Is(err, ErrUnsupportedType), name)
+			assert.Contains(t, err.Error(), fmt.Sprintf("cannot scan %T into %s", v, name))
+		}
+	}
+
+	// The types listed follow the options.
+	var d Date
+	err := SQLOptions{EpochDays: true}.Column(&d).Scan(true)
+	assert.EqualError(t, err, "civil: unsupported type: cannot scan bool into Date; want string, []byte, time.Time, *time.Time, sql.NullTime or int64")
+	var jd SQLiteJulianDay
+	err = jd.Scan(true)
+	assert.EqualError(t, err, "civil: unsupported type: cannot scan bool into SQLiteJulianDay; want string, []byte, time.Time, *time.Time, sql.NullTime, float64 or int64")
+}
+
+func TestSQLColumn_UnsupportedTypes(t *testing.T) {
+	var s string
+	c := SQLOptions{}.Column(&s)
+	err := c.Scan("2020-02-29")
+	assert.True(t, errors.Is(err, ErrUnsupportedType))
+	assert.EqualError(t, err, "civil: unsupported type: cannot scan into *string")
+	_, err = c.Value()
+	assert.True(t, errors.Is(err, ErrUnsupportedType))
+
+	// Scanning needs a pointer.
+	assert.True(t, errors.Is(SQLOptions{}.Column(Date{}).Scan("2020-02-29"), ErrUnsupportedType))
+}
+
+func TestScan_ParseErrors(t *testing.T) {
+	type TC struct {
+		In  sql.Scanner
+		Src interface{}
+		Err string
+	}
+	tcs := []TC{
+		TC{In: &Date{}, Src: "2020-02-30", Err: `civil: cannot scan string into Date, want "2006-01-02": `},
+		TC{In: &Date{}, Src: []byte("29/02/2020"), Err: `civil: cannot scan []uint8 into Date, want "2006-01-02": `},
+		TC{In: &Time{}, Src: "25:00:00", Err: `civil: cannot scan string into Time, want "15:04:05.999999999": `},
+		TC{In: &Time{}, Src: []byte("3pm"), Err: `civil: cannot scan []uint8 into Time, want "15:04:05.999999999": `},
+		TC{In: &DateTime{}, Src: "2020-02-29", Err: `civil: cannot scan string into DateTime, want "2006-01-02T15:04:05.999999999": `},
+		TC{In: &DateTime{}, Src: []byte("2020-02-29X03:42"), Err: `civil: cannot scan []uint8 into DateTime, want "2006-01-02T15:04:05.999999999": `},
+	}
+	for _, tc := range tcs {
+		err := tc.In.Scan(tc.Src)
+		assert.Error(t, err, tc.Src)
+		if err == nil {
+			continue
+		}
+		assert.Contains(t, err.Error(), tc.Err, tc.Src)
+
+		// The parse error is still reachable.
+		var pe *ParseError
+		assert.True(t, errors.As(err, &pe), tc.Src)
+	}
+}
+
+func TestDialectMySQL_Errors(t *testing.T) {
+	opts := SQLOptions{Dialect: DialectMySQL, Parse: ParseOptions{Strict: true}}
+
+	var dt DateTime
+	assert.Error(t, opts.Column(&dt).Scan("0000-00-00 00:00:00"))
+}
+
+func TestScan_Infinity_Errors(t *testing.T) {
+	d := Date{2020, 2, 29}
+	err := d.Scan("infinity")
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+	assert.EqualError(t, err, "civil: year out of range: cannot scan infinity into Date unless SQLOptions.Infinity is set")
+	assert.Equal(t, Date{2020, 2, 29}, d)
+
+	var dt DateTime
+	assert.True(t, errors.Is(dt.Scan([]byte("-infinity")), ErrYearOutOfRange))
+	var nd NullDate
+	assert.True(t, errors.Is(nd.Scan("infinity"), ErrYearOutOfRange))
+
+	// Only the exact PostgreSQL spellings are recognized.
+	assert.False(t, errors.Is(d.Scan("Infinity"), ErrYearOutOfRange))
+}
+
+func TestDate_EpochDays_Errors(t *testing.T) {
+	var d Date
+	assert.True(t, errors.Is(d.Scan(int64(18321)), ErrUnsupportedType))
+	assert.Equal(t, Date{}, d)
+}
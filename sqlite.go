@@ -0,0 +1,130 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"time"
+)
+
+// SQLiteJulianDay is a DateTime stored in SQLite as a Julian day number,
+// a REAL as returned by the julianday function. Values are exact to the
+// millisecond. Use it for columns written in that form, converting with
+// SQLiteJulianDay(dt) and DateTime(jd); a Date is stored as midnight of
+// its day.
+//
+// Scan accepts Unix times and the strings of the datetime function as
+// well, since SQLite columns may hold values of any type. The plain Date
+// and DateTime types store and scan such strings.
+type SQLiteJulianDay DateTime
+
+// String returns the datetime in the format of DateTime.String.
+func (jd SQLiteJulianDay) String() string {
+	return DateTime(jd).String()
+}
+
+// Value implements the database/sql/driver valuer interface.
+func (jd SQLiteJulianDay) Value() (driver.Value, error) {
+	ms, ok := DateTime(jd).localTimestamp(time.Millisecond)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v does not fit in a Julian day number", ErrYearOutOfRange, jd)
+	}
+	return unixJulianDay + float64(ms)/float64(dayMillis), nil
+}
+
+// Scan implements the database/sql scanner interface.
+func (jd *SQLiteJulianDay) Scan(value interface{}) error {
+	return (*DateTime)(jd).scanSQLite(value, "SQLiteJulianDay")
+}
+
+// SQLiteUnixTime is a DateTime stored in SQLite as the seconds since
+// 1970-01-01 00:00:00, an INTEGER as returned by the unixepoch function.
+// Fractional seconds are truncated. Scan accepts the same values as
+// SQLiteJulianDay.Scan.
+type SQLiteUnixTime DateTime
+
+// String returns the datetime in the format of DateTime.String.
+func (u SQLiteUnixTime) String() string {
+	return DateTime(u).String()
+}
+
+// Value implements the database/sql/driver valuer interface.
+func (u SQLiteUnixTime) Value() (driver.Value, error) {
+	s, ok := DateTime(u).localTimestamp(time.Second)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v does not fit in a Unix time", ErrYearOutOfRange, u)
+	}
+	return s, nil
+}
+
+// Scan implements the database/sql scanner interface.
+func (u *SQLiteUnixTime) Scan(value interface{}) error {
+	return (*DateTime)(u).scanSQLite(value, "SQLiteUnixTime")
+}
+
+// unixJulianDay is the Julian day number of 1970-01-01 00:00:00.
+const unixJulianDay = 2440587.5
+
+// dayMillis is the number of milliseconds in a day.
+const dayMillis = int64(24 * time.Hour / time.Millisecond)
+
+// scanSQLite is like Scan but also accepts the Julian day numbers, as
+// float64, and Unix times, as int64, of the SQLite date and time functions.
+// typeName names the type scanned into in errors.
+func (dt *DateTime) scanSQLite(value interface{}, typeName string) error {
+	var val DateTime
+	var err error
+	switch v := timeSource(value).(type) {
+	case float64:
+		val, err = dateTimeFromJulianDay(v)
+	case int64:
+		val, err = dateTimeFromUnixSeconds(v)
+	case nil, string, []byte, time.Time:
+		return dt.Scan(value)
+	default:
+		return errScanType(value, typeName, "float64", "int64")
+	}
+	if err != nil {
+		return err
+	}
+	*dt = val
+	return nil
+}
+
+// dateTimeFromJulianDay returns the datetime of the Julian day number jd,
+// rounded to the millisecond.
+func dateTimeFromJulianDay(jd float64) (DateTime, error) {
+	ms := math.Round((jd - unixJulianDay) * float64(dayMillis))
+	if math.IsNaN(ms) || math.Abs(ms) > 1<<62 {
+		return DateTime{}, fmt.Errorf("%w: %v is not a Julian day number", ErrInvalidFormat, jd)
+	}
+	dt := dateTimeFromLocalTimestamp(int64(ms), time.Millisecond)
+	if err := checkYear(dt.Date.Year); err != nil {
+		return DateTime{}, err
+	}
+	return dt, nil
+}
+
+// dateTimeFromUnixSeconds returns the datetime s seconds after
+// 1970-01-01 00:00:00.
+func dateTimeFromUnixSeconds(s int64) (DateTime, error) {
+	dt := dateTimeFromLocalTimestamp(s, time.Second)
+	if err := checkYear(dt.Date.Year); err != nil {
+		return DateTime{}, err
+	}
+	return dt, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"database/sql/driver"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLite_Value(t *testing.T) {
+	type TC struct {
+		In  driver.Valuer
+		Out driver.Value
+	}
+	dt := DateTime{Date{2020, 2, 29}, Time{12, 0, 0, 500000000}}
+	tcs := []TC{
+		TC{In: SQLiteJulianDay{Date: Date{2020, 2, 29}}, Out: 2458908.5},
+		TC{In: SQLiteJulianDay(dt), Out: 2458909.0 + 0.5/86400},
+		TC{In: SQLiteJulianDay{Date: Date{1970, 1, 1}}, Out: 2440587.5},
+		TC{In: SQLiteUnixTime{Date: Date{2020, 2, 29}}, Out: int64(1582934400)},
+		TC{In: SQLiteUnixTime(dt), Out: int64(1582977600)},
+		TC{In: SQLiteUnixTime{Date: Date{1969, 12, 31}}, Out: int64(-86400)},
+	}
+	for _, tc := range tcs {
+		v, err := tc.In.Value()
+		assert.NoError(t, err, tc.In)
+		assert.Equal(t, tc.Out, v, tc.In)
+	}
+}
+
+func TestSQLite_Scan(t *testing.T) {
+	type TC struct {
+		In  interface{}
+		Out DateTime
+	}
+	tcs := []TC{
+		TC{In: 2458908.5, Out: DateTime{Date: Date{2020, 2, 29}}},
+		TC{In: 2458909.0 + 0.5/86400, Out: DateTime{Date{2020, 2, 29}, Time{12, 0, 0, 500000000}}},
+		TC{In: 2458908.654525, Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 30, 960000000}}},
+		TC{In: int64(1582947751), Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}},
+		TC{In: int64(-1), Out: DateTime{Date{1969, 12, 31}, Time{23, 59, 59, 0}}},
+		TC{In: "2020-02-29 03:42:31.123", Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 123000000}}},
+		TC{In: []byte("2020-02-29T03:42:31"), Out: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}},
+	}
+	for _, tc := range tcs {
+		var jd SQLiteJulianDay
+		assert.NoError(t, jd.Scan(tc.In), tc.In)
+		assert.Equal(t, tc.Out, DateTime(jd), tc.In)
+
+		var u SQLiteUnixTime
+		assert.NoError(t, u.Scan(tc.In), tc.In)
+		assert.Equal(t, tc.Out, DateTime(u), tc.In)
+	}
+}
+
+func TestSQLite_RoundTrip(t *testing.T) {
+	dt := DateTime{Date{1066, 10, 14}, Time{9, 30, 15, 250000000}}
+
+	v, err := SQLiteJulianDay(dt).Value()
+	assert.NoError(t, err)
+	var jd SQLiteJulianDay
+	assert.NoError(t, jd.Scan(v))
+	assert.Equal(t, dt, DateTime(jd))
+
+	v, err = SQLiteUnixTime(dt).Value()
+	assert.NoError(t, err)
+	var u SQLiteUnixTime
+	assert.NoError(t, u.Scan(v))
+	assert.Equal(t, DateTime{dt.Date, Time{9, 30, 15, 0}}, DateTime(u))
+	assert.Equal(t, "1066-10-14T09:30:15", u.String())
+}
+
+/* === ERRORS === */
+
+func TestSQLite_Errors(t *testing.T) {
+	// Plain Date and DateTime values do not take numbers.
+	var dt DateTime
+	assert.True(t, errors.Is(dt.Scan(2458908.5), ErrUnsupportedType))
+	assert.True(t, errors.Is(dt.Scan(int64(1582934400)), ErrUnsupportedType))
+	var d Date
+	assert.True(t, errors.Is(d.Scan(2458908.5), ErrUnsupportedType))
+
+	var jd SQLiteJulianDay
+	assert.True(t, errors.Is(jd.Scan(math.NaN()), ErrInvalidFormat))
+	assert.True(t, errors.Is(jd.Scan(math.Inf(1)), ErrInvalidFormat))
+	assert.True(t, errors.Is(jd.Scan(1e30), ErrInvalidFormat))
+	assert.True(t, errors.Is(jd.Scan(int64(math.MaxInt64)), ErrYearOutOfRange))
+	assert.True(t, errors.Is(jd.Scan(true), ErrUnsupportedType))
+	assert.Equal(t, SQLiteJulianDay{}, jd)
+
+	_, err := SQLiteUnixTime{Date: Date{Year: 1 << 60}}.Value()
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+	_, err = SQLiteJulianDay{Date: Date{Year: 1 << 60}}.Value()
+	assert.True(t, errors.Is(err, ErrYearOutOfRange))
+}
@@ -0,0 +1,166 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"time"
+)
+
+// Strftime returns the date formatted according to a C/Python/Ruby style
+// strftime format. See DateTime.Strftime for the supported directives.
+// Time-of-day directives format as midnight.
+func (d Date) Strftime(format string) string {
+	return string(appendStrftime(nil, format, d.In(time.UTC)))
+}
+
+// Strftime returns the time formatted according to a C/Python/Ruby style
+// strftime format. See DateTime.Strftime for the supported directives.
+// Date directives format as January 1 of year 0.
+func (t Time) Strftime(format string) string {
+	return string(appendStrftime(nil, format, t.onDate0()))
+}
+
+// Strftime returns the datetime formatted according to a C/Python/Ruby style
+// strftime format, for example "%Y-%m-%d %H:%M:%S". The supported directives
+// are:
+//
+//	%Y  year, at least four digits        %C  century (year / 100)
+//	%y  year without century (00-99)      %G  ISO 8601 week-based year
+//	%m  month (01-12)                     %V  ISO 8601 week number (01-53)
+//	%B  full month name                   %b  abbreviated month name (also %h)
+//	%d  day of the month (01-31)          %e  day of the month, space padded
+//	%j  day of the year (001-366)         %A  full weekday name
+//	%a  abbreviated weekday name          %u  weekday, Monday = 1 (1-7)
+//	%w  weekday, Sunday = 0 (0-6)         %H  hour (00-23)
+//	%k  hour, space padded                %I  hour on a 12-hour clock (01-12)
+//	%l  12-hour clock hour, space padded  %p  AM or PM
+//	%P  am or pm                          %M  minute (00-59)
+//	%S  second (00-59)                    %L  milliseconds (000-999)
+//	%f  microseconds (000000-999999)      %N  nanoseconds (000000000-999999999)
+//	%F  same as %Y-%m-%d                  %T  same as %H:%M:%S
+//	%D  same as %m/%d/%y                  %R  same as %H:%M
+//	%n  newline                           %t  tab
+//	%%  a literal '%'
+//
+// Zone directives such as %z and %Z, and any other unrecognized directive,
+// are copied to the output unchanged because civil values carry no location.
+func (dt DateTime) Strftime(format string) string {
+	return string(appendStrftime(nil, format, dt.In(time.UTC)))
+}
+
+func appendStrftime(b []byte, format string, t time.Time) []byte {
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' || i+1 == len(format) {
+			b = append(b, c)
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'Y':
+			b = appendInt(b, t.Year(), 4, '0')
+		case 'C':
+			b = appendInt(b, t.Year()/100, 2, '0')
+		case 'y':
+			b = appendInt(b, t.Year()%100, 2, '0')
+		case 'G':
+			year, _ := t.ISOWeek()
+			b = appendInt(b, year, 4, '0')
+		case 'V':
+			_, week := t.ISOWeek()
+			b = appendInt(b, week, 2, '0')
+		case 'm':
+			b = appendInt(b, int(t.Month()), 2, '0')
+		case 'B':
+			b = append(b, t.Month().String()...)
+		case 'b', 'h':
+			b = append(b, t.Month().String()[:3]...)
+		case 'd':
+			b = appendInt(b, t.Day(), 2, '0')
+		case 'e':
+			b = appendInt(b, t.Day(), 2, ' ')
+		case 'j':
+			b = appendInt(b, t.YearDay(), 3, '0')
+		case 'A':
+			b = append(b, t.Weekday().String()...)
+		case 'a':
+			b = append(b, t.Weekday().String()[:3]...)
+		case 'u':
+			wd := int(t.Weekday())
+			if wd == 0 {
+				wd = 7
+			}
+			b = appendInt(b, wd, 1, '0')
+		case 'w':
+			b = appendInt(b, int(t.Weekday()), 1, '0')
+		case 'H':
+			b = appendInt(b, t.Hour(), 2, '0')
+		case 'k':
+			b = appendInt(b, t.Hour(), 2, ' ')
+		case 'I':
+			b = appendInt(b, hour12(t.Hour()), 2, '0')
+		case 'l':
+			b = appendInt(b, hour12(t.Hour()), 2, ' ')
+		case 'p':
+			if t.Hour() < 12 {
+				b = append(b, "AM"...)
+			} else {
+				b = append(b, "PM"...)
+			}
+		case 'P':
+			if t.Hour() < 12 {
+				b = append(b, "am"...)
+			} else {
+				b = append(b, "pm"...)
+			}
+		case 'M':
+			b = appendInt(b, t.Minute(), 2, '0')
+		case 'S':
+			b = appendInt(b, t.Second(), 2, '0')
+		case 'L':
+			b = appendInt(b, t.Nanosecond()/1e6, 3, '0')
+		case 'f':
+			b = appendInt(b, t.Nanosecond()/1e3, 6, '0')
+		case 'N':
+			b = appendInt(b, t.Nanosecond(), 9, '0')
+		case 'F':
+			b = appendStrftime(b, "%Y-%m-%d", t)
+		case 'T':
+			b = appendStrftime(b, "%H:%M:%S", t)
+		case 'D':
+			b = appendStrftime(b, "%m/%d/%y", t)
+		case 'R':
+			b = appendStrftime(b, "%H:%M", t)
+		case 'n':
+			b = append(b, '\n')
+		case 't':
+			b = append(b, '\t')
+		case '%':
+			b = append(b, '%')
+		default:
+			b = append(b, '%', format[i])
+		}
+	}
+	return b
+}
+
+// hour12 converts an hour in [0,23] to the 12-hour clock, in [1,12].
+func hour12(hour int) int {
+	hour %= 12
+	if hour == 0 {
+		hour = 12
+	}
+	return hour
+}
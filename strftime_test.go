@@ -0,0 +1,61 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTime_Strftime(t *testing.T) {
+	dt := DateTime{Date: Date{2020, 2, 29}, Time: Time{15, 4, 5, 123456789}}
+
+	type TC struct {
+		Format string
+		Out    string
+	}
+	tcs := []TC{
+		TC{Format: "%Y-%m-%d %H:%M:%S", Out: "2020-02-29 15:04:05"},
+		TC{Format: "%F %T", Out: "2020-02-29 15:04:05"},
+		TC{Format: "%D %R", Out: "02/29/20 15:04"},
+		TC{Format: "%a %A %b %h %B", Out: "Sat Saturday Feb Feb February"},
+		TC{Format: "%C %y %j %u %w", Out: "20 20 060 6 6"},
+		TC{Format: "%G-W%V", Out: "2020-W09"},
+		TC{Format: "%I:%M %p|%l %P|%k", Out: "03:04 PM| 3 pm|15"},
+		TC{Format: "%L %f %N", Out: "123 123456 123456789"},
+		TC{Format: "%e|%n|%t|%%", Out: "29|\n|\t|%"},
+		TC{Format: "%z %Q trailing %", Out: "%z %Q trailing %"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, dt.Strftime(tc.Format), tc.Format)
+	}
+}
+
+func TestDate_Strftime(t *testing.T) {
+	d := Date{Year: 2020, Month: 3, Day: 4}
+
+	assert.Equal(t, "2020-03-04", d.Strftime("%Y-%m-%d"))
+	assert.Equal(t, " 4.03.2020 00:00", d.Strftime("%e.%m.%Y %H:%M"))
+	assert.Equal(t, "0987", Date{Year: 987, Month: 1, Day: 1}.Strftime("%Y"))
+}
+
+func TestTime_Strftime(t *testing.T) {
+	tm := Time{Hour: 0, Minute: 7, Second: 9}
+
+	assert.Equal(t, "00:07:09", tm.Strftime("%H:%M:%S"))
+	assert.Equal(t, "12:07 AM", tm.Strftime("%I:%M %p"))
+	assert.Equal(t, "12 PM", Time{Hour: 12}.Strftime("%I %p"))
+}
@@ -0,0 +1,123 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestText_JSONMapKeys(t *testing.T) {
+	in := map[Date]int{{2020, 2, 29}: 1, {2021, 3, 1}: 2}
+	b, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"2020-02-29":1,"2021-03-01":2}`, string(b))
+	var out map[Date]int
+	assert.NoError(t, json.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+
+	times := map[Time]string{{Hour: 9}: "open", {Hour: 17, Minute: 30}: "close"}
+	b, err = json.Marshal(times)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"09:00:00":"open","17:30:00":"close"}`, string(b))
+	var timesOut map[Time]string
+	assert.NoError(t, json.Unmarshal(b, &timesOut))
+	assert.Equal(t, times, timesOut)
+
+	var dts map[DateTime]bool
+	assert.NoError(t, json.Unmarshal([]byte(`{"2020-02-29T03:42:31":true}`), &dts))
+	assert.Equal(t, map[DateTime]bool{{Date{2020, 2, 29}, Time{3, 42, 31, 0}}: true}, dts)
+	assert.Error(t, json.Unmarshal([]byte(`{"2020-02-30":1}`), &out))
+}
+
+func TestText_XML(t *testing.T) {
+	type Event struct {
+		Day   Date     `xml:"day,attr"`
+		Start Time     `xml:"start"`
+		At    DateTime `xml:"at"`
+	}
+	in := Event{Date{2020, 2, 29}, Time{Hour: 9}, DateTime{Date{2020, 2, 29}, Time{Hour: 9}}}
+	b, err := xml.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `<Event day="2020-02-29"><start>09:00:00</start><at>2020-02-29T09:00:00</at></Event>`, string(b))
+
+	var out Event
+	assert.NoError(t, xml.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestText_JSONSpaceSeparator(t *testing.T) {
+	var row struct {
+		CreatedAt DateTime `json:"created_at"`
+	}
+	in := []byte(`{"created_at":"2020-02-29 03:42:31.123456"}`)
+	assert.Error(t, json.Unmarshal(in, &row))
+
+	var sqlRow struct {
+		CreatedAt DateTimeSQL `json:"created_at"`
+	}
+	assert.NoError(t, json.Unmarshal(in, &sqlRow))
+	assert.Equal(t, DateTimeSQL{Date{2020, 2, 29}, Time{3, 42, 31, 123456000}}, sqlRow.CreatedAt)
+
+	b, err := json.Marshal(sqlRow)
+	assert.NoError(t, err)
+	assert.Equal(t, string(in), string(b))
+
+	// The 'T' and 't' separators are read too.
+	var dt DateTimeSQL
+	assert.NoError(t, dt.UnmarshalText([]byte("2020-02-29t03:42:31")))
+	assert.Equal(t, "2020-02-29 03:42:31", dt.String())
+}
+
+func TestText_JSONNull(t *testing.T) {
+	d := Date{2020, 2, 29}
+	assert.NoError(t, d.UnmarshalJSON([]byte("null")))
+	assert.Equal(t, Date{2020, 2, 29}, d)
+
+	tm := Time{3, 42, 31, 0}
+	assert.NoError(t, tm.UnmarshalJSON([]byte("null")))
+	assert.Equal(t, Time{3, 42, 31, 0}, tm)
+
+	var v struct {
+		D  Date
+		T  Time
+		DT DateTime
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"D":null,"T":null,"DT":null}`), &v))
+	assert.Equal(t, DateTime{}, v.DT)
+
+	assert.Error(t, d.UnmarshalJSON([]byte(`"null"`)))
+}
+
+func TestText_FixedPrecision(t *testing.T) {
+	dt := DateTimeMillis{Date{2020, 2, 29}, Time{3, 42, 31, 0}}
+	want := "2020-02-29T03:42:31.000"
+
+	b, err := dt.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, want, string(b))
+
+	type Row struct{ At DateTimeMillis }
+	b, err = xml.Marshal(Row{dt})
+	assert.NoError(t, err)
+	assert.Equal(t, "<Row><At>"+want+"</At></Row>", string(b))
+
+	var row Row
+	assert.NoError(t, xml.Unmarshal(b, &row))
+	assert.Equal(t, dt, row.At)
+}
@@ -0,0 +1,168 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A FieldError reports a field of a Date, Time or DateTime whose value is out
+// of range.
+type FieldError struct {
+	Component Component // the field, such as ComponentMonth
+	Value     int       // the value of the field
+	Min, Max  int       // the range of valid values
+	Err       error     // a sentinel error such as ErrInvalidMonth
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%v: %s %d is not in [%d,%d]", e.Err, e.Component, e.Value, e.Min, e.Max)
+}
+
+// Unwrap returns e.Err.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// A ValidationError lists every field of a value that is out of range, in
+// the order of the fields, as returned by the Validate methods.
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Is reports whether any of the field errors matches target, so that
+// errors.Is examines each of them.
+func (e *ValidationError) Is(target error) bool {
+	for _, f := range e.Fields {
+		if errors.Is(f, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first field error that matches target, so that errors.As
+// examines each of them.
+func (e *ValidationError) As(target interface{}) bool {
+	for _, f := range e.Fields {
+		if errors.As(f, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns nil if d is a valid date, and otherwise a
+// *ValidationError describing each of its fields that is out of range. The
+// year must be in [MinYear,MaxYear]. The day is checked against the length
+// of the month, or against [1,31] if the month itself is out of range.
+func (d Date) Validate() error {
+	return validationError(dateFieldErrors(d.Year, d.Month, d.Day))
+}
+
+// Validate returns nil if t is a valid time, and otherwise a
+// *ValidationError describing each of its fields that is out of range. The
+// end-of-day time 24:00 is reported as an out-of-range hour.
+func (t Time) Validate() error {
+	return validationError(timeFieldErrors(t.Hour, t.Minute, t.Second, t.Nanosecond))
+}
+
+// Validate returns nil if dt is a valid datetime, and otherwise a
+// *ValidationError describing each field of its date and time that is out
+// of range.
+func (dt DateTime) Validate() error {
+	fields := dateFieldErrors(dt.Date.Year, dt.Date.Month, dt.Date.Day)
+	fields = append(fields, timeFieldErrors(dt.Time.Hour, dt.Time.Minute, dt.Time.Second, dt.Time.Nanosecond)...)
+	return validationError(fields)
+}
+
+func validationError(fields []*FieldError) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: fields}
+}
+
+// dateFieldErrors returns an error for each of the fields that is out of
+// range.
+func dateFieldErrors(year int, month time.Month, day int) []*FieldError {
+	var errs []*FieldError
+	if err := checkYear(year); err != nil {
+		errs = append(errs, err.(*FieldError))
+	}
+	maxDay := 31
+	if month < time.January || month > time.December {
+		errs = append(errs, &FieldError{ComponentMonth, int(month), 1, 12, ErrInvalidMonth})
+	} else {
+		maxDay = daysIn(month, year)
+	}
+	if day < 1 || day > maxDay {
+		errs = append(errs, &FieldError{ComponentDay, day, 1, maxDay, ErrInvalidDay})
+	}
+	return errs
+}
+
+// timeFieldErrors returns an error for each of the fields that is out of
+// range.
+func timeFieldErrors(hour, minute, second, nanosecond int) []*FieldError {
+	var errs []*FieldError
+	for _, f := range []FieldError{
+		{ComponentHour, hour, 0, 23, ErrInvalidTime},
+		{ComponentMinute, minute, 0, 59, ErrInvalidTime},
+		{ComponentSecond, second, 0, 59, ErrInvalidTime},
+		{ComponentFraction, nanosecond, 0, 999999999, ErrInvalidTime},
+	} {
+		if f.Value < f.Min || f.Value > f.Max {
+			f := f
+			errs = append(errs, &f)
+		}
+	}
+	return errs
+}
+
+// checkYear returns a *FieldError if year is outside [MinYear,MaxYear].
+func checkYear(year int) error {
+	if year < MinYear || year > MaxYear {
+		return &FieldError{ComponentYear, year, MinYear, MaxYear, ErrYearOutOfRange}
+	}
+	return nil
+}
+
+// checkDate returns the first error from dateFieldErrors, if any.
+func checkDate(year int, month time.Month, day int) error {
+	if errs := dateFieldErrors(year, month, day); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// checkTime returns the first error from timeFieldErrors, if any.
+func checkTime(hour, minute, second, nanosecond int) error {
+	if errs := timeFieldErrors(hour, minute, second, nanosecond); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
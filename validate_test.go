@@ -0,0 +1,77 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	type TC struct {
+		In         interface{ Validate() error }
+		Components []Component
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 2, 29}},
+		TC{In: Time{23, 59, 59, 999999999}},
+		TC{In: DateTime{Date{2020, 2, 29}, Time{3, 42, 31, 0}}},
+		/* === ERRORS === */
+		TC{In: Date{2021, 2, 29}, Components: []Component{ComponentDay}},
+		TC{In: Date{2021, 13, 32}, Components: []Component{ComponentMonth, ComponentDay}},
+		TC{In: Date{}, Components: []Component{ComponentMonth, ComponentDay}},
+		TC{In: Time{24, 0, 0, 0}, Components: []Component{ComponentHour}},
+		TC{In: Time{-1, 60, 61, -1}, Components: []Component{ComponentHour, ComponentMinute, ComponentSecond, ComponentFraction}},
+		TC{In: DateTime{Date{2020, 4, 31}, Time{3, 60, 0, 0}}, Components: []Component{ComponentDay, ComponentMinute}},
+	}
+	for _, tc := range tcs {
+		err := tc.In.Validate()
+		if tc.Components == nil {
+			assert.NoError(t, err, "%v", tc.In)
+			continue
+		}
+		var ve *ValidationError
+		if assert.True(t, errors.As(err, &ve), "%v", tc.In) {
+			var got []Component
+			for _, f := range ve.Fields {
+				got = append(got, f.Component)
+			}
+			assert.Equal(t, tc.Components, got, "%v", tc.In)
+		}
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	err := Date{2021, 13, 32}.Validate()
+	assert.EqualError(t, err, "civil: invalid month: month 13 is not in [1,12]; civil: invalid day: day 32 is not in [1,31]")
+	assert.True(t, errors.Is(err, ErrInvalidMonth))
+	assert.True(t, errors.Is(err, ErrInvalidDay))
+	assert.False(t, errors.Is(err, ErrInvalidTime))
+
+	var fe *FieldError
+	assert.True(t, errors.As(Time{3, 42, 75, 0}.Validate(), &fe))
+	assert.Equal(t, FieldError{ComponentSecond, 75, 0, 59, ErrInvalidTime}, *fe)
+
+	fe = nil
+	assert.True(t, errors.As(err, &fe))
+	assert.Equal(t, ComponentMonth, fe.Component)
+
+	wrapped := fmt.Errorf("loading row: %w", Time{24, 0, 0, 0}.Validate())
+	assert.True(t, errors.Is(wrapped, ErrInvalidTime))
+	assert.False(t, errors.Is(wrapped, ErrInvalidDay))
+}
@@ -0,0 +1,214 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Weekday returns the day of the week of d.
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// Weekday returns the day of the week of dt.
+func (dt DateTime) Weekday() time.Weekday {
+	return dt.Date.Weekday()
+}
+
+// IsWeekend reports whether d falls on one of the weekend days, which are
+// Saturday and Sunday if none are given. Pass time.Friday and
+// time.Saturday for much of the Middle East, or a single day for a
+// one-day weekend.
+func (d Date) IsWeekend(weekend ...time.Weekday) bool {
+	if len(weekend) == 0 {
+		weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+	wd := d.Weekday()
+	for _, w := range weekend {
+		if w == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// WeekOfMonth returns the week of the month containing d, from 1 to 5,
+// counting days 1 to 7 as week 1, days 8 to 14 as week 2 and so on. So the
+// second Saturday of a month is always in week 2, whatever day the month
+// starts on.
+func (d Date) WeekOfMonth() int {
+	return (d.Day-1)/7 + 1
+}
+
+// ISOWeek returns the ISO 8601 year and week number in which d occurs. Week
+// ranges from 1 to 53. Jan 01 to Jan 03 of year n might belong to week 52
+// or 53 of year n-1, and Dec 29 to Dec 31 might belong to week 1 of year
+// n+1.
+func (d Date) ISOWeek() (year, week int) {
+	return d.In(time.UTC).ISOWeek()
+}
+
+// ISOWeek returns the ISO 8601 year and week number in which dt occurs, as
+// for Date.ISOWeek.
+func (dt DateTime) ISOWeek() (year, week int) {
+	return dt.Date.ISOWeek()
+}
+
+// FromISOWeek returns the date on the given weekday of the ISO 8601 week of
+// the ISO week-numbering year. It returns an error wrapping ErrInvalidDay if
+// week is not in the range [1,52], or [1,53] in a year with 53 weeks, or if
+// weekday is not a valid time.Weekday.
+func FromISOWeek(year, week int, weekday time.Weekday) (Date, error) {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return Date{}, fmt.Errorf("%w: %d is not a weekday", ErrInvalidDay, weekday)
+	}
+	d := isoWeekStart(year).AddDays((week-1)*7 + isoWeekday(weekday) - 1)
+	if y, w := d.ISOWeek(); week < 1 || y != year || w != week {
+		return Date{}, fmt.Errorf("%w: week %d out of range for ISO year %d", ErrInvalidDay, week, year)
+	}
+	return d, nil
+}
+
+// WeekNumbering selects a scheme for numbering the weeks of a year.
+type WeekNumbering int
+
+const (
+	// WeekNumberingISO numbers weeks as ISO 8601 does, from Monday to
+	// Sunday, with week 1 the week containing the year's first Thursday.
+	// The year of a week may differ from the calendar year, as described
+	// for Date.ISOWeek.
+	WeekNumberingISO WeekNumbering = iota
+
+	// WeekNumberingUS numbers weeks from Sunday to Saturday, with week 1
+	// the week containing January 1, as for WEEKNUM(date) and
+	// WEEKNUM(date, 1) in spreadsheets and as in many retail calendars.
+	// Week 1 may be only one day long, and the last week of the year 53
+	// or 54.
+	WeekNumberingUS
+
+	// WeekNumberingMonday numbers weeks from Monday to Sunday, with week 1
+	// the week containing January 1, as for WEEKNUM(date, 2).
+	WeekNumberingMonday
+)
+
+// WeekNumber returns the year and week number in which d occurs under the
+// week numbering scheme n. Under WeekNumberingUS and WeekNumberingMonday
+// the year is always d.Year.
+func (d Date) WeekNumber(n WeekNumbering) (year, week int) {
+	var first time.Weekday
+	switch n {
+	case WeekNumberingUS:
+		first = time.Sunday
+	case WeekNumberingMonday:
+		first = time.Monday
+	default:
+		return d.ISOWeek()
+	}
+	jan1 := Date{Year: d.Year, Month: time.January, Day: 1}
+	return d.Year, d.DaysSince(jan1.StartOfWeek(first))/7 + 1
+}
+
+// ISOWeekDate returns the date in ISO 8601 extended week-date format,
+// YYYY-Www-D, where D is the weekday from 1 (Monday) to 7 (Sunday). The year
+// is the ISO week-numbering year, which may differ from d.Year in the first
+// and last days of January and December.
+func (d Date) ISOWeekDate() string {
+	year, week := d.ISOWeek()
+	b := make([]byte, 0, len("2006-W01-1"))
+	b = appendInt(b, year, 4, '0')
+	b = append(b, "-W"...)
+	b = appendInt(b, week, 2, '0')
+	b = append(b, '-')
+	b = appendInt(b, isoWeekday(d.Weekday()), 1, '0')
+	return string(b)
+}
+
+// ParseISOWeekDate parses an ISO 8601 week date in either the extended
+// format YYYY-Www-D, as produced by ISOWeekDate, or the basic format
+// YYYYWwwD, and returns the date it represents. A malformed string is an
+// error wrapping ErrInvalidFormat, and a week or weekday that is out of
+// range for the year is an error wrapping ErrInvalidDay.
+func ParseISOWeekDate(s string) (Date, error) {
+	var yearPart, weekPart, dayPart string
+	switch {
+	case len(s) == len("2006-W01-1") && s[4] == '-' && s[5] == 'W' && s[8] == '-':
+		yearPart, weekPart, dayPart = s[:4], s[6:8], s[9:]
+	case len(s) == len("2006W011") && s[4] == 'W':
+		yearPart, weekPart, dayPart = s[:4], s[5:7], s[7:]
+	default:
+		return Date{}, fmt.Errorf("%w: %q is not an ISO 8601 week date", ErrInvalidFormat, s)
+	}
+
+	year, err := parseDigits(yearPart)
+	if err != nil {
+		return Date{}, fmt.Errorf("%w: invalid year in ISO week date %q", ErrInvalidFormat, s)
+	}
+	week, err := parseDigits(weekPart)
+	if err != nil {
+		return Date{}, fmt.Errorf("%w: invalid week in ISO week date %q", ErrInvalidFormat, s)
+	}
+	weekday, err := parseDigits(dayPart)
+	if err != nil || weekday < 1 || weekday > 7 {
+		return Date{}, fmt.Errorf("%w: invalid weekday in ISO week date %q", ErrInvalidFormat, s)
+	}
+
+	d, err := FromISOWeek(year, week, time.Weekday(weekday%7))
+	if err != nil {
+		return Date{}, fmt.Errorf("%w in ISO week date %q", err, s)
+	}
+	return d, nil
+}
+
+// StartOfWeek returns the first day of the week containing d, for weeks that
+// begin on first, such as time.Monday for ISO 8601 weeks or time.Sunday for
+// US weeks.
+func (d Date) StartOfWeek(first time.Weekday) Date {
+	offset := (int(d.Weekday()) - int(first) + 7) % 7
+	return d.AddDays(-offset)
+}
+
+// EndOfWeek returns the last day of the week containing d, for weeks that
+// begin on first. It is six days after d.StartOfWeek(first).
+func (d Date) EndOfWeek(first time.Weekday) Date {
+	return d.StartOfWeek(first).AddDays(6)
+}
+
+// isoWeekStart returns the Monday of week 1 of the ISO week-numbering year,
+// which is the week containing January 4.
+func isoWeekStart(year int) Date {
+	jan4 := Date{Year: year, Month: time.January, Day: 4}
+	return jan4.AddDays(1 - isoWeekday(jan4.Weekday()))
+}
+
+// isoWeekday numbers the days of the week from 1 (Monday) to 7 (Sunday).
+func isoWeekday(wd time.Weekday) int {
+	if wd == time.Sunday {
+		return 7
+	}
+	return int(wd)
+}
+
+// parseDigits parses a non-empty string consisting only of ASCII digits.
+func parseDigits(s string) (int, error) {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, strconv.ErrSyntax
+		}
+	}
+	return strconv.Atoi(s)
+}
@@ -0,0 +1,203 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_ISOWeekDate_RoundTrip(t *testing.T) {
+	type TC struct {
+		In  Date
+		Out string
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 2, 29}, Out: "2020-W09-6"},
+		TC{In: Date{2020, 3, 1}, Out: "2020-W09-7"},
+		TC{In: Date{2008, 12, 29}, Out: "2009-W01-1"},
+		TC{In: Date{2010, 1, 3}, Out: "2009-W53-7"},
+		TC{In: Date{2021, 1, 4}, Out: "2021-W01-1"},
+		TC{In: Date{2020, 12, 31}, Out: "2020-W53-4"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Out, tc.In.ISOWeekDate())
+
+		d, err := ParseISOWeekDate(tc.Out)
+		assert.NoError(t, err, tc.Out)
+		assert.Equal(t, tc.In, d, tc.Out)
+	}
+}
+
+func TestFromISOWeek(t *testing.T) {
+	type TC struct {
+		Year    int
+		Week    int
+		Weekday time.Weekday
+		Out     Date
+	}
+	tcs := []TC{
+		TC{Year: 2020, Week: 9, Weekday: time.Saturday, Out: Date{2020, 2, 29}},
+		TC{Year: 2020, Week: 9, Weekday: time.Sunday, Out: Date{2020, 3, 1}},
+		TC{Year: 2009, Week: 1, Weekday: time.Monday, Out: Date{2008, 12, 29}},
+		TC{Year: 2009, Week: 53, Weekday: time.Sunday, Out: Date{2010, 1, 3}},
+		TC{Year: 2020, Week: 53, Weekday: time.Thursday, Out: Date{2020, 12, 31}},
+	}
+	for _, tc := range tcs {
+		d, err := FromISOWeek(tc.Year, tc.Week, tc.Weekday)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.Out, d, "%d-W%d %v", tc.Year, tc.Week, tc.Weekday)
+
+		year, week := d.ISOWeek()
+		assert.Equal(t, tc.Year, year, "%v", d)
+		assert.Equal(t, tc.Week, week, "%v", d)
+		year, week = DateTime{Date: d, Time: Time{23, 59, 59, 0}}.ISOWeek()
+		assert.Equal(t, tc.Year, year, "%v", d)
+		assert.Equal(t, tc.Week, week, "%v", d)
+	}
+
+	for _, tc := range []TC{
+		TC{Year: 2021, Week: 53, Weekday: time.Monday},
+		TC{Year: 2021, Week: 0, Weekday: time.Monday},
+		TC{Year: 2021, Week: 1, Weekday: 7},
+		TC{Year: 2021, Week: 1, Weekday: -1},
+	} {
+		_, err := FromISOWeek(tc.Year, tc.Week, tc.Weekday)
+		assert.True(t, errors.Is(err, ErrInvalidDay), "%d-W%d %v", tc.Year, tc.Week, tc.Weekday)
+	}
+}
+
+func TestParseISOWeekDate(t *testing.T) {
+	d, err := ParseISOWeekDate("2020W096")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{2020, 2, 29}, d)
+
+	type TC struct {
+		In  string
+		Err error
+	}
+	tcs := []TC{
+		TC{In: "2020-W09", Err: ErrInvalidFormat},
+		TC{In: "2020-W09-0", Err: ErrInvalidFormat},
+		TC{In: "2020-W09-8", Err: ErrInvalidFormat},
+		TC{In: "2020-W00-1", Err: ErrInvalidDay},
+		TC{In: "2021-W53-1", Err: ErrInvalidDay},
+		TC{In: "2020-w09-6", Err: ErrInvalidFormat},
+		TC{In: "2020-W+9-6", Err: ErrInvalidFormat},
+		TC{In: "2020-09-06", Err: ErrInvalidFormat},
+	}
+	for _, tc := range tcs {
+		_, err := ParseISOWeekDate(tc.In)
+		assert.True(t, errors.Is(err, tc.Err), "%s: %v", tc.In, err)
+	}
+	_, err = ParseISOWeekDate("2021-W53-1")
+	assert.EqualError(t, err, `civil: invalid day: week 53 out of range for ISO year 2021 in ISO week date "2021-W53-1"`)
+}
+
+func TestDate_Weekday(t *testing.T) {
+	type TC struct {
+		In      Date
+		Weekday time.Weekday
+		Week    int
+	}
+	tcs := []TC{
+		TC{In: Date{2020, 2, 1}, Weekday: time.Saturday, Week: 1},
+		TC{In: Date{2020, 2, 7}, Weekday: time.Friday, Week: 1},
+		TC{In: Date{2020, 2, 8}, Weekday: time.Saturday, Week: 2},
+		TC{In: Date{2020, 2, 29}, Weekday: time.Saturday, Week: 5},
+		TC{In: Date{2020, 3, 1}, Weekday: time.Sunday, Week: 1},
+		TC{In: Date{1970, 1, 1}, Weekday: time.Thursday, Week: 1},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Weekday, tc.In.Weekday(), "%v", tc.In)
+		assert.Equal(t, tc.Weekday, DateTime{Date: tc.In, Time: Time{23, 59, 59, 0}}.Weekday(), "%v", tc.In)
+		assert.Equal(t, tc.Week, tc.In.WeekOfMonth(), "%v", tc.In)
+	}
+}
+
+func TestDate_IsWeekend(t *testing.T) {
+	// 2020-02-27 is a Thursday.
+	thu, fri, sat, sun := Date{2020, 2, 27}, Date{2020, 2, 28}, Date{2020, 2, 29}, Date{2020, 3, 1}
+	assert.False(t, thu.IsWeekend())
+	assert.False(t, fri.IsWeekend())
+	assert.True(t, sat.IsWeekend())
+	assert.True(t, sun.IsWeekend())
+
+	assert.False(t, thu.IsWeekend(time.Friday, time.Saturday))
+	assert.True(t, fri.IsWeekend(time.Friday, time.Saturday))
+	assert.True(t, sat.IsWeekend(time.Friday, time.Saturday))
+	assert.False(t, sun.IsWeekend(time.Friday, time.Saturday))
+
+	assert.True(t, fri.IsWeekend(time.Friday))
+	assert.False(t, sat.IsWeekend(time.Friday))
+}
+
+func TestDate_WeekNumber(t *testing.T) {
+	type TC struct {
+		In     Date
+		ISO    string
+		US     int
+		Monday int
+	}
+	tcs := []TC{
+		// 2022-01-01 is a Saturday.
+		TC{In: Date{2022, 1, 1}, ISO: "2021-W52", US: 1, Monday: 1},
+		TC{In: Date{2022, 1, 2}, ISO: "2021-W52", US: 2, Monday: 1},
+		TC{In: Date{2022, 1, 3}, ISO: "2022-W01", US: 2, Monday: 2},
+		TC{In: Date{2022, 12, 31}, ISO: "2022-W52", US: 53, Monday: 53},
+		// 2000 is a leap year starting on a Saturday.
+		TC{In: Date{2000, 12, 31}, ISO: "2000-W52", US: 54, Monday: 53},
+		TC{In: Date{2023, 1, 1}, ISO: "2022-W52", US: 1, Monday: 1},
+		TC{In: Date{2023, 1, 2}, ISO: "2023-W01", US: 1, Monday: 2},
+	}
+	for _, tc := range tcs {
+		year, week := tc.In.WeekNumber(WeekNumberingISO)
+		assert.Equal(t, tc.ISO, fmt.Sprintf("%04d-W%02d", year, week), "%v", tc.In)
+
+		year, week = tc.In.WeekNumber(WeekNumberingUS)
+		assert.Equal(t, tc.In.Year, year, "%v", tc.In)
+		assert.Equal(t, tc.US, week, "%v", tc.In)
+
+		year, week = tc.In.WeekNumber(WeekNumberingMonday)
+		assert.Equal(t, tc.In.Year, year, "%v", tc.In)
+		assert.Equal(t, tc.Monday, week, "%v", tc.In)
+	}
+}
+
+func TestDate_StartEndOfWeek(t *testing.T) {
+	type TC struct {
+		In    Date
+		First time.Weekday
+		Start Date
+		End   Date
+	}
+	tcs := []TC{
+		// 2020-02-29 is a Saturday.
+		TC{In: Date{2020, 2, 29}, First: time.Monday, Start: Date{2020, 2, 24}, End: Date{2020, 3, 1}},
+		TC{In: Date{2020, 2, 29}, First: time.Sunday, Start: Date{2020, 2, 23}, End: Date{2020, 2, 29}},
+		TC{In: Date{2020, 2, 29}, First: time.Saturday, Start: Date{2020, 2, 29}, End: Date{2020, 3, 6}},
+		TC{In: Date{2020, 3, 1}, First: time.Monday, Start: Date{2020, 2, 24}, End: Date{2020, 3, 1}},
+		TC{In: Date{2020, 3, 1}, First: time.Sunday, Start: Date{2020, 3, 1}, End: Date{2020, 3, 7}},
+		TC{In: Date{2021, 1, 1}, First: time.Monday, Start: Date{2020, 12, 28}, End: Date{2021, 1, 3}},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.Start, tc.In.StartOfWeek(tc.First), "%v from %v", tc.In, tc.First)
+		assert.Equal(t, tc.End, tc.In.EndOfWeek(tc.First), "%v from %v", tc.In, tc.First)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// The XML methods encode Date, Time and DateTime as the XML Schema types
+// xs:date, xs:time and xs:dateTime. Values are decoded as by UnmarshalText,
+// after trimming surrounding whitespace as XML Schema requires. To accept a
+// timezone, decode through ParseOptions.Target with Offset set: an xs:date
+// or xs:time timezone such as "Z" or "-05:00" is then discarded, and an
+// xs:dateTime timezone is handled as by ParseOptions.ParseDateTime.
+var (
+	_ xml.Marshaler       = Date{}
+	_ xml.Unmarshaler     = (*Date)(nil)
+	_ xml.MarshalerAttr   = Date{}
+	_ xml.UnmarshalerAttr = (*Date)(nil)
+	_ xml.Marshaler       = Time{}
+	_ xml.Unmarshaler     = (*Time)(nil)
+	_ xml.MarshalerAttr   = Time{}
+	_ xml.UnmarshalerAttr = (*Time)(nil)
+	_ xml.Marshaler       = DateTime{}
+	_ xml.Unmarshaler     = (*DateTime)(nil)
+	_ xml.MarshalerAttr   = DateTime{}
+	_ xml.UnmarshalerAttr = (*DateTime)(nil)
+	_ xml.Unmarshaler     = (*ParseTarget)(nil)
+	_ xml.UnmarshalerAttr = (*ParseTarget)(nil)
+)
+
+// MarshalXML implements the xml.Marshaler interface, writing the date as an
+// xs:date.
+func (d Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(d.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return d.unmarshalXSD(ParseOptions{}, s)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (d Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: d.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (d *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.unmarshalXSD(ParseOptions{}, attr.Value)
+}
+
+func (d *Date) unmarshalXSD(o ParseOptions, s string) error {
+	s = strings.TrimSpace(s)
+	if o.Offset != OffsetReject {
+		s = trimXSDTimezone(s)
+	}
+	val, err := o.decoding().ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = val
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface, writing the time as an
+// xs:time.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(t.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (t *Time) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return t.unmarshalXSD(ParseOptions{}, s)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: t.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.unmarshalXSD(ParseOptions{}, attr.Value)
+}
+
+func (t *Time) unmarshalXSD(o ParseOptions, s string) error {
+	s = strings.TrimSpace(s)
+	if o.Offset != OffsetReject {
+		s = trimXSDTimezone(s)
+	}
+	val, err := o.decoding().ParseTime(s)
+	if err != nil {
+		return err
+	}
+	*t = val
+	return nil
+}
+
+// MarshalXML implements the xml.Marshaler interface, writing the datetime
+// as an xs:dateTime.
+func (dt DateTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(dt.String(), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (dt *DateTime) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return dt.unmarshalXSD(ParseOptions{}, s)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (dt DateTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: dt.String()}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (dt *DateTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return dt.unmarshalXSD(ParseOptions{}, attr.Value)
+}
+
+func (dt *DateTime) unmarshalXSD(o ParseOptions, s string) error {
+	val, err := o.decoding().ParseDateTime(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	*dt = val
+	return nil
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (t *ParseTarget) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return t.unmarshalXSD(s)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (t *ParseTarget) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.unmarshalXSD(attr.Value)
+}
+
+func (t *ParseTarget) unmarshalXSD(s string) error {
+	switch v := t.v.(type) {
+	case *Date:
+		return v.unmarshalXSD(t.opts, s)
+	case *Time:
+		return v.unmarshalXSD(t.opts, s)
+	case *DateTime:
+		return v.unmarshalXSD(t.opts, s)
+	}
+	return t.unsupported()
+}
+
+// trimXSDTimezone removes a trailing XML Schema timezone, "Z" or ±HH:MM,
+// from s.
+func trimXSDTimezone(s string) string {
+	if n := len(s); n > 0 && s[n-1] == 'Z' {
+		return s[:n-1]
+	}
+	if n := len(s) - len("+00:00"); n > 0 && (s[n] == '+' || s[n] == '-') && s[n+3] == ':' {
+		return s[:n]
+	}
+	return s
+}
@@ -0,0 +1,86 @@
+// Copyright 2016 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civil
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type xmlEvent struct {
+	XMLName xml.Name `xml:"event"`
+	Day     Date     `xml:"day,attr"`
+	Opens   Time     `xml:"opens,attr"`
+	Start   Time     `xml:"start"`
+	At      DateTime `xml:"at"`
+}
+
+func TestXML_RoundTrip(t *testing.T) {
+	in := xmlEvent{
+		XMLName: xml.Name{Local: "event"},
+		Day:     Date{2020, 2, 29},
+		Opens:   Time{Hour: 9},
+		Start:   Time{9, 30, 0, 500000000},
+		At:      DateTime{Date{2020, 2, 29}, Time{9, 30, 0, 0}},
+	}
+	b, err := xml.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, `<event day="2020-02-29" opens="09:00:00"><start>09:30:00.500000000</start><at>2020-02-29T09:30:00</at></event>`, string(b))
+
+	var out xmlEvent
+	assert.NoError(t, xml.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestXML_Unmarshal(t *testing.T) {
+	const doc = `<event day=" 2020-02-29 " opens="09:00:00">
+		<start>
+			09:30:00
+		</start>
+		<at>2020-02-29T09:30:00</at>
+	</event>`
+	var out xmlEvent
+	assert.NoError(t, xml.Unmarshal([]byte(doc), &out))
+	assert.Equal(t, xmlEvent{
+		XMLName: xml.Name{Local: "event"},
+		Day:     Date{2020, 2, 29},
+		Opens:   Time{Hour: 9},
+		Start:   Time{9, 30, 0, 0},
+		At:      DateTime{Date{2020, 2, 29}, Time{9, 30, 0, 0}},
+	}, out)
+
+	assert.Error(t, xml.Unmarshal([]byte(`<event day="2020-02-29Z"></event>`), &out))
+	assert.Error(t, xml.Unmarshal([]byte(`<event><start>25:00:00</start></event>`), &out))
+}
+
+func TestXML_Unmarshal_Offset(t *testing.T) {
+	opts := ParseOptions{Offset: OffsetStrip}
+
+	var d Date
+	assert.NoError(t, xml.Unmarshal([]byte(`<day> 2020-02-29Z </day>`), opts.Target(&d)))
+	assert.Equal(t, Date{2020, 2, 29}, d)
+
+	var tm Time
+	assert.NoError(t, opts.Target(&tm).UnmarshalXMLAttr(xml.Attr{Value: "09:00:00-05:00"}))
+	assert.Equal(t, Time{Hour: 9}, tm)
+
+	var dt DateTime
+	assert.NoError(t, xml.Unmarshal([]byte(`<at>2020-02-29T09:30:00+01:00</at>`), opts.Target(&dt)))
+	assert.Equal(t, DateTime{Date{2020, 2, 29}, Time{9, 30, 0, 0}}, dt)
+
+	assert.Error(t, xml.Unmarshal([]byte(`<at>2020-02-29T09:30:00+01:00</at>`), &dt))
+}